@@ -0,0 +1,67 @@
+// 初回の管理者の付与など、ユーザーの権限をコマンドラインから変更する
+//
+//	go run ./cmd/admin -email someone@example.com -role admin
+//	go run ./cmd/admin -user <user id> -role organizer
+//
+// 本番のイメージでは /app/admin としてビルドされる（MySQLの設定だけを読み込む）
+//
+//	docker compose exec app /app/admin -email someone@example.com -role admin
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/noonyuu/nfc/back/internal/config"
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/infrastructure/db"
+	"github.com/noonyuu/nfc/back/internal/infrastructure/persistence"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+func main() {
+	email := flag.String("email", "", "対象ユーザーのメールアドレス")
+	userID := flag.String("user", "", "対象ユーザーのID")
+	role := flag.String("role", string(model.RoleAdmin), "付与する権限 (user, organizer, moderator, admin)")
+	flag.Parse()
+
+	if (*email == "") == (*userID == "") {
+		fmt.Fprintln(os.Stderr, "-email か -user のどちらか一方を指定してください")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// サーバーの設定（JWTの鍵・認証プロバイダーなど）は不要なため、MySQLの設定だけを読み込む
+	cfg, err := config.LoadMySQL()
+	if err != nil {
+		log.Fatal("設定エラー:\n", err)
+	}
+	dbConn, err := db.ConnectMysql(*cfg)
+	if err != nil {
+		log.Fatal("DB接続エラー: ", err)
+	}
+	defer dbConn.Close()
+
+	ctx := context.Background()
+
+	if *email != "" {
+		user, err := persistence.NewUserPersistence(dbConn).FindByEmail(ctx, *email)
+		if err != nil {
+			log.Fatal("ユーザー検索エラー: ", err)
+		}
+		if user == nil {
+			log.Fatalf("ユーザーが見つかりません: %s", *email)
+		}
+		*userID = user.ID
+	}
+
+	roleUseCase := usecase.NewRoleUseCase(persistence.NewRolePersistence(dbConn))
+	if err := roleUseCase.SetRole(ctx, *userID, model.Role(*role)); err != nil {
+		log.Fatal("権限の変更エラー: ", err)
+	}
+
+	fmt.Printf("ユーザー %s の権限を %s に変更しました\n", *userID, *role)
+}
@@ -1,41 +1,80 @@
 package main
 
 import (
-	"fmt"
+	"context"
 	"log"
-	"net/http"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/noonyuu/nfc/back/graph/resolver"
 	"github.com/noonyuu/nfc/back/internal/config"
 	"github.com/noonyuu/nfc/back/internal/infrastructure/db"
+	"github.com/noonyuu/nfc/back/internal/logger"
 	"github.com/noonyuu/nfc/back/internal/server"
+	"github.com/noonyuu/nfc/back/internal/tracing"
 )
 
 func main() {
-	fmt.Println("Starting the application...")
+	// 設定と設定ファイルの読み込み（誤りがあればすべて表示して起動しない）
+	var files server.Files
+	cfg, err := config.Load(files.Loaders()...)
+	if err != nil {
+		log.Fatal("設定エラー:\n", err)
+	}
+
+	logger.Setup(os.Stdout, logger.Options{Level: cfg.Log.Level, JSON: cfg.Log.JSON})
+	slog.Info("starting the application", slog.String("env", cfg.Env))
 
-	cfg := config.Load()
+	// トレースの送信先の初期化（停止時に送信前のスパンを送る）
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Trace)
+	if err != nil {
+		log.Fatal("トレースの初期化エラー: ", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("failed to shut down tracing", "error", err)
+		}
+	}()
+
+	// JWTの署名鍵の読み込み（鍵が無い場合は起動しない）
+	jwtKeys, err := config.InitJWT(cfg.JWT)
+	if err != nil {
+		log.Fatal("JWT鍵の読み込みエラー: ", err)
+	}
 
 	// MySQLの初期化
-	dbConn, err := db.ConnectMysql(cfg)
+	dbConn, err := db.ConnectMysql(cfg.MySQL)
 	if err != nil {
 		log.Fatal("DB接続エラー: ", err)
 	}
 	defer dbConn.Close()
 
 	// Redisの初期化
-	redisConn, err := db.ConnectRedis()
+	redisConn, err := db.ConnectRedis(cfg)
 	if err != nil {
 		log.Fatal("Redis接続エラー: ", err)
 	}
 	defer redisConn.Close()
 
 	// GraphQLの初期化
-	graphql := &resolver.Resolver{DB: dbConn}
+	graphql := &resolver.Resolver{DB: dbConn, MaxPageSize: cfg.GraphQL.MaxPageSize}
 	// サーバー起動
-	handler := server.NewRouter(dbConn, redisConn, graphql)
-	err = http.ListenAndServe(":8080", handler)
+	handler, err := server.NewRouter(cfg, &files, dbConn, redisConn, graphql, jwtKeys)
 	if err != nil {
-		log.Fatal("サーバー起動エラー: ", err)
+		log.Fatal("サーバーの初期化エラー: ", err)
+	}
+
+	// SIGINT・SIGTERMを受け取ったら処理中のリクエストを待って停止する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := server.Serve(ctx, cfg, handler); err != nil {
+		log.Fatal("サーバーエラー: ", err)
 	}
+	log.Print("サーバーを停止しました")
 }
@@ -0,0 +1,21 @@
+-- 追記のみの監査ログ（対象が削除されても残すため外部キーは張らない）
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  actor_id VARCHAR(255),
+  action VARCHAR(64) NOT NULL,
+  target_type VARCHAR(64),
+  target_id VARCHAR(255),
+  changes JSON,
+  ip_address VARCHAR(64),
+  user_agent VARCHAR(512),
+  created_at DATETIME(6) NOT NULL,
+  INDEX idx_audit_logs_actor (actor_id, id),
+  INDEX idx_audit_logs_target (target_type, target_id, id),
+  INDEX idx_audit_logs_action (action, id)
+) ENGINE=InnoDB;
+
+CREATE TRIGGER audit_logs_no_update BEFORE UPDATE ON audit_logs
+  FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only';
+
+CREATE TRIGGER audit_logs_no_delete BEFORE DELETE ON audit_logs
+  FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only';
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS event_organizers (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  event_id VARCHAR(255),
+  user_id VARCHAR(255),
+  created_at DATETIME,
+  updated_at DATETIME,
+  FOREIGN KEY (event_id) REFERENCES events(id),
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  UNIQUE KEY uniq_event_organizer (event_id, user_id)
+) ENGINE=InnoDB;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+  id VARCHAR(255) PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  scopes VARCHAR(255) NOT NULL,
+  expires_at DATETIME,
+  last_used_at DATETIME,
+  created_at DATETIME,
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  UNIQUE KEY uniq_token_hash (token_hash)
+) ENGINE=InnoDB;
@@ -0,0 +1,2 @@
+ALTER TABLE users
+  ADD COLUMN role VARCHAR(32) NOT NULL DEFAULT 'user';
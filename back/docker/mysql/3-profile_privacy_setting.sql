@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS profile_privacy_settings (
+  profile_id VARCHAR(255) PRIMARY KEY,
+  email_visibility VARCHAR(32) NOT NULL DEFAULT 'self',
+  name_visibility VARCHAR(32) NOT NULL DEFAULT 'connections',
+  avatar_url_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  graduation_year_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  affiliation_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  bio_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  card_avatar_url BOOLEAN NOT NULL DEFAULT TRUE,
+  card_graduation_year BOOLEAN NOT NULL DEFAULT TRUE,
+  card_affiliation BOOLEAN NOT NULL DEFAULT TRUE,
+  card_bio BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at DATETIME,
+  updated_at DATETIME,
+  FOREIGN KEY (profile_id) REFERENCES profiles(id)
+) ENGINE=InnoDB;
@@ -0,0 +1,4 @@
+ALTER TABLE providers
+  DROP INDEX provider_id,
+  ADD UNIQUE KEY uniq_provider_account (provider, provider_id),
+  ADD UNIQUE KEY uniq_user_provider (user_id, provider);
@@ -1,3 +1,24 @@
+-- 追記のみの監査ログ（対象が削除されても残すため外部キーは張らない）
+CREATE TABLE IF NOT EXISTS audit_logs (
+  id BIGINT AUTO_INCREMENT PRIMARY KEY,
+  actor_id VARCHAR(255),
+  action VARCHAR(64) NOT NULL,
+  target_type VARCHAR(64),
+  target_id VARCHAR(255),
+  changes JSON,
+  ip_address VARCHAR(64),
+  user_agent VARCHAR(512),
+  created_at DATETIME(6) NOT NULL,
+  INDEX idx_audit_logs_actor (actor_id, id),
+  INDEX idx_audit_logs_target (target_type, target_id, id),
+  INDEX idx_audit_logs_action (action, id)
+) ENGINE=InnoDB;
+
+CREATE TRIGGER audit_logs_no_update BEFORE UPDATE ON audit_logs
+  FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only';
+
+CREATE TRIGGER audit_logs_no_delete BEFORE DELETE ON audit_logs
+  FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only';
 CREATE TABLE IF NOT EXISTS events (
   id VARCHAR(255) PRIMARY KEY,
   name VARCHAR(255),
@@ -37,6 +58,28 @@ CREATE TABLE IF NOT EXISTS users (
   created_at DATETIME,
   updated_at DATETIME
 ) ENGINE=InnoDB;
+CREATE TABLE IF NOT EXISTS event_organizers (
+  id INT AUTO_INCREMENT PRIMARY KEY,
+  event_id VARCHAR(255),
+  user_id VARCHAR(255),
+  created_at DATETIME,
+  updated_at DATETIME,
+  FOREIGN KEY (event_id) REFERENCES events(id),
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  UNIQUE KEY uniq_event_organizer (event_id, user_id)
+) ENGINE=InnoDB;
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+  id VARCHAR(255) PRIMARY KEY,
+  user_id VARCHAR(255) NOT NULL,
+  name VARCHAR(255) NOT NULL,
+  token_hash CHAR(64) NOT NULL,
+  scopes VARCHAR(255) NOT NULL,
+  expires_at DATETIME,
+  last_used_at DATETIME,
+  created_at DATETIME,
+  FOREIGN KEY (user_id) REFERENCES users(id),
+  UNIQUE KEY uniq_token_hash (token_hash)
+) ENGINE=InnoDB;
 CREATE TABLE IF NOT EXISTS profiles (
   id VARCHAR(255) PRIMARY KEY,
   avatar_url VARCHAR(255),
@@ -54,6 +97,24 @@ CREATE TABLE IF NOT EXISTS profiles (
   created_at DATETIME,
   updated_at DATETIME
 ) ENGINE=InnoDB;
+ALTER TABLE users
+  ADD COLUMN role VARCHAR(32) NOT NULL DEFAULT 'user';
+CREATE TABLE IF NOT EXISTS profile_privacy_settings (
+  profile_id VARCHAR(255) PRIMARY KEY,
+  email_visibility VARCHAR(32) NOT NULL DEFAULT 'self',
+  name_visibility VARCHAR(32) NOT NULL DEFAULT 'connections',
+  avatar_url_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  graduation_year_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  affiliation_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  bio_visibility VARCHAR(32) NOT NULL DEFAULT 'public',
+  card_avatar_url BOOLEAN NOT NULL DEFAULT TRUE,
+  card_graduation_year BOOLEAN NOT NULL DEFAULT TRUE,
+  card_affiliation BOOLEAN NOT NULL DEFAULT TRUE,
+  card_bio BOOLEAN NOT NULL DEFAULT TRUE,
+  created_at DATETIME,
+  updated_at DATETIME,
+  FOREIGN KEY (profile_id) REFERENCES profiles(id)
+) ENGINE=InnoDB;
 CREATE TABLE IF NOT EXISTS profile_skills (
   id INT AUTO_INCREMENT PRIMARY KEY,
   profile_id VARCHAR(255),
@@ -64,6 +125,10 @@ CREATE TABLE IF NOT EXISTS profile_skills (
   FOREIGN KEY (skill_id) REFERENCES skills(id),
   UNIQUE KEY uniq_profile_skill (profile_id, skill_id)
 ) ENGINE=InnoDB;
+ALTER TABLE providers
+  DROP INDEX provider_id,
+  ADD UNIQUE KEY uniq_provider_account (provider, provider_id),
+  ADD UNIQUE KEY uniq_user_provider (user_id, provider);
 CREATE TABLE IF NOT EXISTS works (
   id VARCHAR(255) PRIMARY KEY,
   title VARCHAR(255),
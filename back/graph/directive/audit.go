@@ -0,0 +1,165 @@
+package directive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// 監査ログを記録する
+type AuditRecorder interface {
+	Record(ctx context.Context, entry usecase.AuditEntry) error
+}
+
+// 監査ログの変更前後の値として、対象をIDから取得する（存在しない場合はnil）
+type AuditTargetLoader func(ctx context.Context, id string) (interface{}, error)
+
+// 成功したミューテーションを全て監査ログに記録するgqlgenの拡張
+// 対象の型は戻り値の型、IDは引数の id / <型名>Id / input.id または戻り値の id から決める
+type Audit struct {
+	recorder AuditRecorder
+	loaders  map[string]AuditTargetLoader
+	schema   *ast.Schema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = &Audit{}
+
+// loadersは型名ごとの取得方法（変更前後の値を同じ形で記録するため、変更後も取得し直す）
+func NewAudit(recorder AuditRecorder, loaders map[string]AuditTargetLoader) *Audit {
+	return &Audit{recorder: recorder, loaders: loaders}
+}
+
+func (a *Audit) ExtensionName() string {
+	return "Audit"
+}
+
+func (a *Audit) Validate(schema graphql.ExecutableSchema) error {
+	a.schema = schema.Schema()
+	return nil
+}
+
+func (a *Audit) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Object != "Mutation" || fc.Field.Field == nil || fc.Field.Definition == nil {
+		return next(ctx)
+	}
+
+	targetType, targetID := a.auditTarget(fc.Field.Name, fc.Field.Definition.Type.Name(), fc.Args)
+	loader := a.loaders[targetType]
+
+	var before interface{}
+	if loader != nil && targetID != "" {
+		before = loadAuditTarget(ctx, loader, targetID)
+	}
+
+	res, err := next(ctx)
+	if err != nil {
+		return res, err
+	}
+
+	if targetID == "" {
+		targetID = idOf(res)
+	}
+	after := res
+	if isDeleteMutation(fc.Field.Name) {
+		after = nil
+	} else if loader != nil && targetID != "" {
+		after = loadAuditTarget(ctx, loader, targetID)
+	}
+
+	entry := usecase.AuditEntry{
+		Action:     domainModel.AuditActionMutationPrefix + fc.Field.Name,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Before:     before,
+		After:      after,
+	}
+	if viewer := auth.ViewerFromContext(ctx); viewer != nil {
+		entry.ActorID = viewer.UserID
+	}
+	if err := a.recorder.Record(ctx, entry); err != nil {
+		slog.ErrorContext(ctx, "failed to record audit log", "error", err)
+	}
+	return res, nil
+}
+
+func loadAuditTarget(ctx context.Context, loader AuditTargetLoader, id string) interface{} {
+	target, err := loader(ctx, id)
+	if err != nil {
+		return nil
+	}
+	return target
+}
+
+// 削除系のミューテーションは変更後の値を残さない
+func isDeleteMutation(name string) bool {
+	for _, prefix := range []string{"delete", "remove", "revoke", "unlink"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// 戻り値が対象を表さない（Booleanを返す）ミューテーションの、対象の型とIDを持つ引数
+var auditArgumentTargets = map[string]struct{ typeName, arg string }{
+	"revokePersonalAccessToken": {"PersonalAccessToken", "id"},
+	"revokeSession":             {"Session", "id"},
+	// 連携はユーザーごとにプロバイダー名で一意なため、プロバイダー名をIDとして記録する
+	"unlinkProvider": {"Provider", "provider"},
+}
+
+// ミューテーションの対象の型とID（IDが引数から決まらない場合は空文字）
+func (a *Audit) auditTarget(field, returnType string, args map[string]interface{}) (targetType, targetID string) {
+	if target, ok := auditArgumentTargets[field]; ok {
+		if v := args[target.arg]; v != nil {
+			targetID = fmt.Sprint(v)
+		}
+		return target.typeName, targetID
+	}
+	if def := a.schema.Types[returnType]; def == nil || def.Kind == ast.Scalar || def.Kind == ast.Enum {
+		returnType = ""
+	}
+	return returnType, auditTargetID(args, returnType)
+}
+
+// 引数から対象のIDを探す（id、<型名>Id、input.id の順）
+func auditTargetID(args map[string]interface{}, targetType string) string {
+	keys := []string{"id"}
+	if targetType != "" {
+		keys = append(keys, strings.ToLower(targetType[:1])+targetType[1:]+"Id")
+	}
+	for _, key := range keys {
+		if v, ok := args[key]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+	return idOf(args["input"])
+}
+
+// JSONにした場合のidの値（無い場合は空文字）
+func idOf(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]interface{}
+	if json.Unmarshal(data, &fields) != nil || fields["id"] == nil {
+		return ""
+	}
+	return fmt.Sprint(fields["id"])
+}
@@ -0,0 +1,134 @@
+package directive
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const auditTestSchema = `
+type Work { id: String! }
+type Profile { id: String! }
+type WorkSkill { id: Int! }
+enum Role { USER ADMIN }
+
+type Query { work(id: String!): Work }
+type Mutation {
+  createWork(input: NewWork!): Work!
+  updateWork(input: UpdateWork!): Work!
+  deleteWork(id: String!): Work!
+  addWorkSkill(workId: String!, skillId: String!): WorkSkill!
+  setRole(userId: String!, role: Role!): Role!
+  revokePersonalAccessToken(id: String!): Boolean!
+  revokeSession(id: String!): Boolean!
+  unlinkProvider(provider: String!): Boolean!
+}
+
+input NewWork { title: String! }
+input UpdateWork { id: String! title: String }
+`
+
+func newTestAudit(t *testing.T) *Audit {
+	t.Helper()
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: auditTestSchema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := NewAudit(nil, nil)
+	if err := a.Validate(&graphql.ExecutableSchemaMock{SchemaFunc: func() *ast.Schema { return schema }}); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+type auditTestInput struct {
+	ID    *string `json:"id"`
+	Title string  `json:"title"`
+}
+
+func TestAuditTarget(t *testing.T) {
+	a := newTestAudit(t)
+	id := "w1"
+
+	tests := []struct {
+		field      string
+		returnType string
+		args       map[string]interface{}
+		wantType   string
+		wantID     string
+	}{
+		// 引数のid
+		{"deleteWork", "Work", map[string]interface{}{"id": "w1"}, "Work", "w1"},
+		// input.id（構造体はJSONにして探す）
+		{"updateWork", "Work", map[string]interface{}{"input": auditTestInput{ID: &id}}, "Work", "w1"},
+		{"updateWork", "Work", map[string]interface{}{"input": map[string]interface{}{"id": "w2"}}, "Work", "w2"},
+		// 作成時は引数にIDがない（戻り値から決める）
+		{"createWork", "Work", map[string]interface{}{"input": auditTestInput{Title: "t"}}, "Work", ""},
+		// <型名>Id は戻り値の型に合うものだけを使う
+		{"addWorkSkill", "WorkSkill", map[string]interface{}{"workId": "w1", "skillId": "s1"}, "WorkSkill", ""},
+		// スカラー・列挙型を返すミューテーションは型を持たない
+		{"setRole", "Role", map[string]interface{}{"userId": "u1"}, "", ""},
+		// Booleanを返すミューテーションは引数から決める
+		{"revokePersonalAccessToken", "Boolean", map[string]interface{}{"id": "pat1"}, "PersonalAccessToken", "pat1"},
+		{"revokeSession", "Boolean", map[string]interface{}{"id": "sess1"}, "Session", "sess1"},
+		{"unlinkProvider", "Boolean", map[string]interface{}{"provider": "google"}, "Provider", "google"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			gotType, gotID := a.auditTarget(tt.field, tt.returnType, tt.args)
+			if gotType != tt.wantType || gotID != tt.wantID {
+				t.Errorf("auditTarget() = (%q, %q), want (%q, %q)", gotType, gotID, tt.wantType, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestIDOf(t *testing.T) {
+	type work struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	}
+	type skill struct {
+		ID int32 `json:"id"`
+	}
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{"struct", work{ID: "w1"}, "w1"},
+		{"pointer", &work{ID: "w1"}, "w1"},
+		{"integer id", skill{ID: 42}, "42"},
+		{"map", map[string]interface{}{"id": "w1"}, "w1"},
+		{"nil", nil, ""},
+		{"nil pointer", (*work)(nil), ""},
+		{"no id", map[string]interface{}{"title": "t"}, ""},
+		{"boolean", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := idOf(tt.v); got != tt.want {
+				t.Errorf("idOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsDeleteMutation(t *testing.T) {
+	for name, want := range map[string]bool{
+		"deleteWork":                true,
+		"removeWorkProfile":         true,
+		"revokeSession":             true,
+		"unlinkProvider":            true,
+		"updateWork":                false,
+		"createWork":                false,
+		"recordCardScan":            false,
+		"revokePersonalAccessToken": true,
+	} {
+		if got := isDeleteMutation(name); got != want {
+			t.Errorf("isDeleteMutation(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package directive
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/auth"
+)
+
+// @ownerで拒否された場合の理由
+const ReasonNotOwner = "NOT_OWNER"
+
+// @auth: 認証済みユーザーのみ実行を許可する
+func Auth(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, Unauthenticated()
+	}
+	if !allowedForToken(ctx, viewer) {
+		return nil, Forbidden(ReasonTokenNotAllowed)
+	}
+	return next(ctx)
+}
+
+// @owner(arg: "input.id"): 指定した引数の値が認証済みユーザーのIDと一致する場合のみ実行を許可する
+func Owner(ctx context.Context, obj interface{}, next graphql.Resolver, arg string) (interface{}, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, Unauthenticated()
+	}
+	if !allowedForToken(ctx, viewer) {
+		return nil, Forbidden(ReasonTokenNotAllowed)
+	}
+
+	value, ok := lookupArgument(ctx, arg)
+	if !ok || value != viewer.UserID {
+		return nil, Forbidden(ReasonNotOwner)
+	}
+	return next(ctx)
+}
+
+// フィールド引数をドット区切りのパスで参照する（例: "input.id"）
+func lookupArgument(ctx context.Context, path string) (string, bool) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Field == nil {
+		return "", false
+	}
+	var current interface{} = fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		if current, ok = m[key]; !ok {
+			return "", false
+		}
+	}
+
+	value, ok := current.(string)
+	return value, ok
+}
@@ -0,0 +1,22 @@
+package directive
+
+import "github.com/noonyuu/nfc/back/internal/apperror"
+
+const (
+	CodeUnauthenticated = apperror.CodeUnauthenticated
+	CodeForbidden       = apperror.CodeForbidden
+)
+
+// 未認証のリクエストに対するエラー
+func Unauthenticated() *apperror.Error {
+	return apperror.Unauthenticated("ログインが必要です。")
+}
+
+// 権限のない操作に対するエラー（reasonには拒否理由を指定する）
+func Forbidden(reason string) *apperror.Error {
+	err := apperror.Forbidden("この操作を行う権限がありません。")
+	if reason != "" {
+		err.With("reason", reason)
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+package directive
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+// 項目を閲覧できるか確認する
+type PrivacyChecker interface {
+	CanView(ctx context.Context, viewerID string, ownerID string, field domainModel.PrivacyField, onCard bool) (bool, error)
+}
+
+// @visibility(field: EMAIL): プロフィールの公開設定で閲覧できない場合はnullを返す
+func NewVisibility(privacy PrivacyChecker) func(ctx context.Context, obj interface{}, next graphql.Resolver, field model.PrivacyField) (interface{}, error) {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, field model.PrivacyField) (interface{}, error) {
+		var ownerID string
+		switch o := obj.(type) {
+		case *model.User:
+			ownerID = o.ID
+		case *model.Profile:
+			ownerID = o.ID
+		default:
+			// 想定外の型には付けない
+			slog.WarnContext(ctx, "@visibility is not supported on this type", "type", fmt.Sprintf("%T", obj))
+			return nil, nil
+		}
+
+		var viewerID string
+		if viewer := auth.ViewerFromContext(ctx); viewer != nil {
+			viewerID = viewer.UserID
+		}
+
+		ok, err := privacy.CanView(ctx, viewerID, ownerID, PrivacyFieldFromGraph(field), onCard(ctx))
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to check visibility", "error", err)
+
+			return nil, apperror.Internal("公開設定の確認中にサーバーエラーが発生しました。")
+		}
+		if !ok {
+			return nil, nil
+		}
+		return next(ctx)
+	}
+}
+
+// NFCカードの読み取り（Query.workProfile { profile }）で取得されたプロフィールか
+// workProfilesByWorkIdやnodeなど他の経路のWorkProfile.profileはカードとして扱わない
+// （カードの表示設定は公開範囲より優先されるため、誰でも公開範囲を超えて閲覧できてしまう）
+func onCard(ctx context.Context) bool {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return false
+	}
+	profile := fc.Parent
+	if !isField(profile, "WorkProfile", "profile") {
+		return false
+	}
+	return isField(profile.Parent, "Query", "workProfile")
+}
+
+func isField(fc *graphql.FieldContext, object, name string) bool {
+	return fc != nil && fc.Field.Field != nil && fc.Object == object && fc.Field.Name == name
+}
+
+// GraphQLの項目をドメインの項目に変換
+func PrivacyFieldFromGraph(field model.PrivacyField) domainModel.PrivacyField {
+	return domainModel.PrivacyField(strings.ToLower(string(field)))
+}
+
+// GraphQLの公開範囲をドメインの公開範囲に変換
+func VisibilityFromGraph(v model.Visibility) domainModel.Visibility {
+	return domainModel.Visibility(strings.ToLower(string(v)))
+}
+
+// ドメインの公開範囲をGraphQLの公開範囲に変換
+func VisibilityToGraph(v domainModel.Visibility) model.Visibility {
+	return model.Visibility(strings.ToUpper(string(v)))
+}
@@ -0,0 +1,66 @@
+package directive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// 親から順にフィールドのcontextを積む（"型.フィールド"）
+func withFieldPath(fields ...[2]string) context.Context {
+	ctx := context.Background()
+	for _, f := range fields {
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Object: f[0],
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: f[1]}},
+		})
+	}
+	return ctx
+}
+
+func TestOnCard(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields [][2]string
+		want   bool
+	}{
+		{
+			name:   "card scan",
+			fields: [][2]string{{"Query", "workProfile"}, {"WorkProfile", "profile"}, {"Profile", "bio"}},
+			want:   true,
+		},
+		{
+			name:   "work profiles by work",
+			fields: [][2]string{{"Query", "workProfilesByWorkId"}, {"WorkProfile", "profile"}, {"Profile", "bio"}},
+		},
+		{
+			name:   "work profiles by profile",
+			fields: [][2]string{{"Query", "workProfilesByProfileId"}, {"WorkProfile", "profile"}, {"Profile", "bio"}},
+		},
+		{
+			name:   "node",
+			fields: [][2]string{{"Query", "node"}, {"WorkProfile", "profile"}, {"Profile", "bio"}},
+		},
+		{
+			name:   "profile query",
+			fields: [][2]string{{"Query", "profile"}, {"Profile", "bio"}},
+		},
+		{
+			name:   "work profile without parent",
+			fields: [][2]string{{"WorkProfile", "profile"}, {"Profile", "bio"}},
+		},
+		{
+			name: "no field context",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := onCard(withFieldPath(tt.fields...)); got != tt.want {
+				t.Errorf("onCard() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
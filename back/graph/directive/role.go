@@ -0,0 +1,52 @@
+package directive
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+// @hasRoleで拒否された場合の理由
+const ReasonInsufficientRole = "INSUFFICIENT_ROLE"
+
+// ユーザーの権限を確認する
+type RoleChecker interface {
+	HasRole(ctx context.Context, userID string, required domainModel.Role) (bool, error)
+}
+
+// @hasRole(role: ADMIN): 指定した権限以上のユーザーのみ実行を許可する
+func NewHasRole(roles RoleChecker) func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, role model.Role) (interface{}, error) {
+		viewer := auth.ViewerFromContext(ctx)
+		if viewer == nil {
+			return nil, Unauthenticated()
+		}
+
+		ok, err := roles.HasRole(ctx, viewer.UserID, RoleFromGraph(role))
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to check role", "error", err)
+
+			return nil, apperror.Internal("権限の確認中にサーバーエラーが発生しました。")
+		}
+		if !ok {
+			return nil, Forbidden(ReasonInsufficientRole)
+		}
+		return next(ctx)
+	}
+}
+
+// GraphQLの権限をドメインの権限に変換
+func RoleFromGraph(role model.Role) domainModel.Role {
+	return domainModel.Role(strings.ToLower(string(role)))
+}
+
+// ドメインの権限をGraphQLの権限に変換
+func RoleToGraph(role domainModel.Role) model.Role {
+	return model.Role(strings.ToUpper(string(role)))
+}
@@ -0,0 +1,44 @@
+package directive
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// パーソナルアクセストークンで拒否された場合の理由
+const (
+	ReasonInsufficientScope = "INSUFFICIENT_SCOPE"
+	ReasonTokenNotAllowed   = "TOKEN_NOT_ALLOWED"
+)
+
+// @scope(requires: "write:works"): パーソナルアクセストークンの場合はスコープを確認する
+func Scope(ctx context.Context, obj interface{}, next graphql.Resolver, requires string) (interface{}, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer != nil && !viewer.HasScope(requires) {
+		return nil, Forbidden(ReasonInsufficientScope)
+	}
+	return next(ctx)
+}
+
+// パーソナルアクセストークンの場合、@scopeの無いフィールドは実行を許可しない
+func allowedForToken(ctx context.Context, viewer *auth.Viewer) bool {
+	if !viewer.IsToken() {
+		return true
+	}
+	fc := graphql.GetFieldContext(ctx)
+	return fc != nil && fc.Field.Definition != nil && fc.Field.Definition.Directives.ForName("scope") != nil
+}
+
+// パーソナルアクセストークンでのクエリ・サブスクリプションにはreadスコープを必要とする
+func RequireReadScope(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	viewer := auth.ViewerFromContext(ctx)
+	oc := graphql.GetOperationContext(ctx)
+	if viewer != nil && oc.Operation != nil && oc.Operation.Operation != ast.Mutation && !viewer.HasScope(auth.ScopeRead) {
+		return apperror.Reject(ctx, Forbidden(ReasonInsufficientScope))
+	}
+	return next(ctx)
+}
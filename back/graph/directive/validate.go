@@ -0,0 +1,198 @@
+package directive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// 複数の項目にまたがる入力の検証（終了日時が開始日時以降かなど）
+// 引数の型が実装していれば、ディレクティブの検証と併せて実行する
+// pathは引数名（例: input）で、返す項目のパスはこれを先頭に付ける
+type InputValidator interface {
+	ValidateInput(path string) []apperror.FieldError
+}
+
+// 入力値の検証ディレクティブ（@length @url @range @pattern）と InputValidator を
+// リゾルバーの実行前にまとめて確認し、違反した全ての項目をパス付きで返すgqlgenの拡張
+type Validation struct {
+	schema *ast.Schema
+	// @patternの正規表現（起動時にコンパイルしておく）
+	patterns map[string]*regexp.Regexp
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = &Validation{}
+
+func NewValidation() *Validation {
+	return &Validation{}
+}
+
+func (v *Validation) ExtensionName() string {
+	return "Validation"
+}
+
+func (v *Validation) Validate(schema graphql.ExecutableSchema) error {
+	v.schema = schema.Schema()
+	v.patterns = map[string]*regexp.Regexp{}
+
+	compile := func(directives ast.DirectiveList) error {
+		for _, d := range directives.ForNames("pattern") {
+			regex, _ := d.ArgumentMap(nil)["regex"].(string)
+			re, err := regexp.Compile(`^(?:` + regex + `)$`)
+			if err != nil {
+				return fmt.Errorf("invalid @pattern %q: %w", regex, err)
+			}
+			v.patterns[regex] = re
+		}
+		return nil
+	}
+	for _, def := range v.schema.Types {
+		for _, f := range def.Fields {
+			if err := compile(f.Directives); err != nil {
+				return err
+			}
+			for _, arg := range f.Arguments {
+				if err := compile(arg.Directives); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (v *Validation) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Field == nil || fc.Field.Definition == nil || len(fc.Field.Definition.Arguments) == 0 {
+		return next(ctx)
+	}
+
+	raw := fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+	var violations []apperror.FieldError
+	for _, arg := range fc.Field.Definition.Arguments {
+		violations = v.walk(violations, arg.Name, arg.Directives, arg.Type, raw[arg.Name])
+	}
+	for _, arg := range fc.Field.Definition.Arguments {
+		if validator, ok := fc.Args[arg.Name].(InputValidator); ok {
+			violations = append(violations, validator.ValidateInput(arg.Name)...)
+		}
+	}
+	if len(violations) > 0 {
+		return nil, apperror.Validation(violations...)
+	}
+	return next(ctx)
+}
+
+// 値を型に沿ってたどり、ディレクティブに違反した項目を追加する
+func (v *Validation) walk(violations []apperror.FieldError, path string, directives ast.DirectiveList, typ *ast.Type, value any) []apperror.FieldError {
+	if value == nil {
+		return violations
+	}
+
+	if typ.Elem != nil {
+		list, ok := value.([]any)
+		if !ok {
+			// リストの型に単一の値を渡した場合（1要素のリストとして扱われる）
+			return v.walk(violations, path, directives, typ.Elem, value)
+		}
+		for i, elem := range list {
+			violations = v.walk(violations, fmt.Sprintf("%s.%d", path, i), directives, typ.Elem, elem)
+		}
+		return violations
+	}
+
+	if def := v.schema.Types[typ.NamedType]; def != nil && def.Kind == ast.InputObject {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return violations
+		}
+		for _, f := range def.Fields {
+			violations = v.walk(violations, path+"."+f.Name, f.Directives, f.Type, obj[f.Name])
+		}
+		return violations
+	}
+
+	for _, d := range directives {
+		if violation, ok := v.check(d, path, value); !ok {
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+// 1つの値をディレクティブで検証する（対象外のディレクティブ・型の場合は常にtrue）
+func (v *Validation) check(d *ast.Directive, path string, value any) (apperror.FieldError, bool) {
+	args := d.ArgumentMap(nil)
+	switch d.Name {
+	case "length":
+		s, ok := value.(string)
+		if !ok {
+			break
+		}
+		n := int64(utf8.RuneCountInString(s))
+		if min, ok := intValue(args["min"]); ok && n < min {
+			return apperror.Field(path, "%d文字以上で入力してください。", min), false
+		}
+		if max, ok := intValue(args["max"]); ok && n > max {
+			return apperror.Field(path, "%d文字以内で入力してください。", max), false
+		}
+	case "url":
+		s, ok := value.(string)
+		if !ok {
+			break
+		}
+		if u, err := url.Parse(s); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return apperror.Field(path, "URLの形式が正しくありません。"), false
+		}
+	case "range":
+		n, ok := intValue(value)
+		if !ok {
+			break
+		}
+		if min, ok := intValue(args["min"]); ok && n < min {
+			return apperror.Field(path, "%d以上の値を入力してください。", min), false
+		}
+		if max, ok := intValue(args["max"]); ok && n > max {
+			return apperror.Field(path, "%d以下の値を入力してください。", max), false
+		}
+	case "pattern":
+		s, ok := value.(string)
+		regex, _ := args["regex"].(string)
+		if re := v.patterns[regex]; ok && re != nil && !re.MatchString(s) {
+			return apperror.Field(path, "形式が正しくありません。"), false
+		}
+	}
+	return apperror.FieldError{}, true
+}
+
+// 変数（JSON）・リテラルの整数を取り出す
+func intValue(value any) (int64, bool) {
+	switch n := value.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case float64:
+		if n != math.Trunc(n) {
+			return 0, false
+		}
+		return int64(n), true
+	case json.Number:
+		i, err := n.Int64()
+		return i, err == nil
+	}
+	return 0, false
+}
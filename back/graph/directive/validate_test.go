@@ -0,0 +1,160 @@
+package directive
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const validationTestSchema = `
+directive @length(min: Int, max: Int) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+directive @url on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+directive @range(min: Int, max: Int) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+directive @pattern(regex: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+input Link {
+  url: String! @url
+  label: String @length(max: 5)
+}
+
+input Item {
+  name: String! @length(min: 1, max: 3)
+  year: Int @range(min: 2000, max: 2100)
+  code: String @pattern(regex: "[a-z]+|x")
+  links: [Link!]
+  tags: [String!] @length(max: 2)
+}
+
+type Query {
+  items(input: [Item!]!, limit: Int @range(min: 1, max: 50)): Int
+}
+`
+
+func newTestValidation(t *testing.T) *Validation {
+	t.Helper()
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: validationTestSchema})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := NewValidation()
+	if err := v.Validate(&graphql.ExecutableSchemaMock{SchemaFunc: func() *ast.Schema { return schema }}); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestValidationWalk(t *testing.T) {
+	v := newTestValidation(t)
+	items := v.schema.Query.Fields.ForName("items")
+
+	tests := []struct {
+		name string
+		// 引数名 → 変数（JSON）から取り出した値
+		args map[string]any
+		want []string
+	}{
+		{
+			name: "valid",
+			args: map[string]any{
+				"input": []any{map[string]any{
+					"name":  "abc",
+					"year":  json.Number("2024"),
+					"code":  "abc",
+					"links": []any{map[string]any{"url": "https://example.com", "label": "home"}},
+					"tags":  []any{"go", "ts"},
+				}},
+				"limit": json.Number("10"),
+			},
+		},
+		{
+			name: "null fields are skipped",
+			args: map[string]any{"input": []any{map[string]any{"name": "abc", "year": nil, "links": nil}}},
+		},
+		{
+			name: "nested paths with list indices",
+			args: map[string]any{"input": []any{
+				map[string]any{"name": "ok"},
+				map[string]any{
+					"name":  "too long",
+					"links": []any{map[string]any{"url": "ftp://example.com"}, map[string]any{"url": "https://example.com", "label": "too long"}},
+				},
+			}},
+			want: []string{"input.1.name", "input.1.links.0.url", "input.1.links.1.label"},
+		},
+		{
+			name: "directive on list applies to each element",
+			args: map[string]any{"input": []any{map[string]any{"name": "a", "tags": []any{"ok", "long"}}}},
+			want: []string{"input.0.tags.1"},
+		},
+		{
+			name: "single value for a list",
+			args: map[string]any{"input": map[string]any{"name": ""}},
+			want: []string{"input.name"},
+		},
+		{
+			name: "json.Number",
+			args: map[string]any{
+				"input": []any{map[string]any{"name": "a", "year": json.Number("1999")}},
+				"limit": json.Number("51"),
+			},
+			want: []string{"input.0.year", "limit"},
+		},
+		{
+			name: "literal integers",
+			args: map[string]any{
+				"input": []any{map[string]any{"name": "a", "year": int64(2101)}},
+				"limit": float64(0),
+			},
+			want: []string{"input.0.year", "limit"},
+		},
+		{
+			// 整数でない値はスキーマの型の検証に任せる
+			name: "non-integer numbers are not checked",
+			args: map[string]any{
+				"input": []any{map[string]any{"name": "a", "year": json.Number("1999.5")}},
+				"limit": float64(0.5),
+			},
+		},
+		{
+			// パターンは値全体に一致する必要がある（選択肢 | を含む場合も全体を囲む）
+			name: "pattern is anchored",
+			args: map[string]any{"input": []any{
+				map[string]any{"name": "a", "code": "abc1"},
+				map[string]any{"name": "b", "code": "1x"},
+				map[string]any{"name": "c", "code": "x"},
+			}},
+			want: []string{"input.0.code", "input.1.code"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, arg := range items.Arguments {
+				for _, violation := range v.walk(nil, arg.Name, arg.Directives, arg.Type, tt.args[arg.Name]) {
+					got = append(got, violation.Path)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationInvalidPattern(t *testing.T) {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: `
+directive @pattern(regex: String!) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+type Query { search(q: String @pattern(regex: "[")): Int }
+`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := NewValidation().Validate(&graphql.ExecutableSchemaMock{SchemaFunc: func() *ast.Schema { return schema }}); err == nil {
+		t.Error("Validate() = nil, want an error for an invalid @pattern")
+	}
+}
@@ -0,0 +1,65 @@
+package loader
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/jmoiron/sqlx"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/vikstrous/dataloadgen"
+)
+
+// 同じバッチにまとめるためにキーを待つ時間
+const wait = 2 * time.Millisecond
+
+// リクエスト単位のDataLoader
+// フィールドリゾルバーから呼ばれたキーをまとめて1回のINクエリで取得する
+type Loaders struct {
+	Work    *dataloadgen.Loader[string, *model.Work]
+	Profile *dataloadgen.Loader[string, *model.Profile]
+	Event   *dataloadgen.Loader[string, *model.Event]
+
+	// 作品IDごとの関連
+	WorkProfiles      *dataloadgen.Loader[string, []*model.Profile]
+	WorkSkills        *dataloadgen.Loader[string, []*model.Skill]
+	WorkEvents        *dataloadgen.Loader[string, []*model.Event]
+	WorkImages        *dataloadgen.Loader[string, []*model.Image]
+	WorkDiagramImages *dataloadgen.Loader[string, []*model.DiagramImage]
+}
+
+func New(db *sqlx.DB) *Loaders {
+	r := &reader{db: db}
+	return &Loaders{
+		Work:    dataloadgen.NewLoader(r.works, dataloadgen.WithWait(wait)),
+		Profile: dataloadgen.NewLoader(r.profiles, dataloadgen.WithWait(wait)),
+		Event:   dataloadgen.NewLoader(r.events, dataloadgen.WithWait(wait)),
+
+		WorkProfiles:      dataloadgen.NewLoader(r.profilesByWorkID, dataloadgen.WithWait(wait)),
+		WorkSkills:        dataloadgen.NewLoader(r.skillsByWorkID, dataloadgen.WithWait(wait)),
+		WorkEvents:        dataloadgen.NewLoader(r.eventsByWorkID, dataloadgen.WithWait(wait)),
+		WorkImages:        dataloadgen.NewLoader(r.imagesByWorkID, dataloadgen.WithWait(wait)),
+		WorkDiagramImages: dataloadgen.NewLoader(r.diagramImagesByWorkID, dataloadgen.WithWait(wait)),
+	}
+}
+
+type loadersKey struct{}
+
+// DataLoaderをcontextに設定する
+func WithLoaders(ctx context.Context, l *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+// contextからDataLoaderを取得する
+func FromContext(ctx context.Context) (*Loaders, bool) {
+	l, ok := ctx.Value(loadersKey{}).(*Loaders)
+	return l, ok
+}
+
+// ルートフィールドごとに新しいDataLoaderを用意する
+// ミューテーションで更新した関連が前のフィールドのキャッシュで返らないようにする
+func Middleware(db *sqlx.DB) graphql.RootFieldMiddleware {
+	return func(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+		return next(WithLoaders(ctx, New(db)))
+	}
+}
@@ -0,0 +1,318 @@
+package loader
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/noonyuu/nfc/back/graph/model"
+)
+
+// IDに対応する行が存在しない
+var ErrNotFound = errors.New("loader: not found")
+
+type reader struct {
+	db *sqlx.DB
+}
+
+// IN句を展開してクエリを実行する
+func (r *reader) query(ctx context.Context, query string, keys []string) (*sqlx.Rows, error) {
+	query, args, err := sqlx.In(query, keys)
+	if err != nil {
+		return nil, err
+	}
+	return r.db.QueryxContext(ctx, r.db.Rebind(query), args...)
+}
+
+// 1キー1行のクエリ結果をキーの順に並べ替える
+func byKey[V any](keys []string, found map[string]V) ([]V, []error) {
+	values := make([]V, len(keys))
+	errs := make([]error, len(keys))
+	for i, key := range keys {
+		v, ok := found[key]
+		if !ok {
+			errs[i] = ErrNotFound
+			continue
+		}
+		values[i] = v
+	}
+	return values, errs
+}
+
+// 1キー複数行のクエリ結果をキーの順に並べ替える
+// 関連がないキーには空のスライスを返す
+func groupByKey[V any](keys []string, groups map[string][]V) ([][]V, []error) {
+	values := make([][]V, len(keys))
+	for i, key := range keys {
+		if g, ok := groups[key]; ok {
+			values[i] = g
+		} else {
+			values[i] = []V{}
+		}
+	}
+	return values, nil
+}
+
+// 取得・走査に失敗した場合は全キーに同じエラーを返す
+func failed(what string, err error) []error {
+	return []error{fmt.Errorf("load %s: %w", what, err)}
+}
+
+func (r *reader) works(ctx context.Context, ids []string) ([]*model.Work, []error) {
+	rows, err := r.query(ctx, `
+		SELECT id, title, description, created_at, updated_at
+		FROM works
+		WHERE id IN (?)
+	`, ids)
+	if err != nil {
+		return nil, failed("works", err)
+	}
+	defer rows.Close()
+
+	found := make(map[string]*model.Work, len(ids))
+	for rows.Next() {
+		work := &model.Work{}
+		if err := rows.Scan(&work.ID, &work.Title, &work.Description, &work.CreatedAt, &work.UpdatedAt); err != nil {
+			return nil, failed("works", err)
+		}
+		found[work.ID] = work
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("works", err)
+	}
+	return byKey(ids, found)
+}
+
+func (r *reader) profiles(ctx context.Context, ids []string) ([]*model.Profile, []error) {
+	rows, err := r.query(ctx, `
+		SELECT id, avatar_url, nick_name, graduation_year, affiliation, bio, created_at, updated_at
+		FROM profiles
+		WHERE id IN (?)
+	`, ids)
+	if err != nil {
+		return nil, failed("profiles", err)
+	}
+	defer rows.Close()
+
+	found := make(map[string]*model.Profile, len(ids))
+	for rows.Next() {
+		profile, err := scanProfile(rows)
+		if err != nil {
+			return nil, failed("profiles", err)
+		}
+		found[profile.ID] = profile
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("profiles", err)
+	}
+	return byKey(ids, found)
+}
+
+func (r *reader) events(ctx context.Context, ids []string) ([]*model.Event, []error) {
+	rows, err := r.query(ctx, `
+		SELECT id, name, description, start_date, end_date, location, created_at, updated_at, created_by, updated_by
+		FROM events
+		WHERE id IN (?)
+	`, ids)
+	if err != nil {
+		return nil, failed("events", err)
+	}
+	defer rows.Close()
+
+	found := make(map[string]*model.Event, len(ids))
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, failed("events", err)
+		}
+		found[event.ID] = event
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("events", err)
+	}
+	return byKey(ids, found)
+}
+
+func (r *reader) profilesByWorkID(ctx context.Context, workIDs []string) ([][]*model.Profile, []error) {
+	rows, err := r.query(ctx, `
+		SELECT wp.work_id, p.id, p.avatar_url, p.nick_name, p.graduation_year, p.affiliation, p.bio, p.created_at, p.updated_at
+		FROM profiles p
+		JOIN work_profiles wp ON p.id = wp.profile_id
+		WHERE wp.work_id IN (?)
+		ORDER BY wp.id
+	`, workIDs)
+	if err != nil {
+		return nil, failed("work profiles", err)
+	}
+	defer rows.Close()
+
+	groups := make(map[string][]*model.Profile, len(workIDs))
+	for rows.Next() {
+		var workID string
+		profile, err := scanProfile(rows, &workID)
+		if err != nil {
+			return nil, failed("work profiles", err)
+		}
+		groups[workID] = append(groups[workID], profile)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("work profiles", err)
+	}
+	return groupByKey(workIDs, groups)
+}
+
+func (r *reader) skillsByWorkID(ctx context.Context, workIDs []string) ([][]*model.Skill, []error) {
+	rows, err := r.query(ctx, `
+		SELECT ws.work_id, s.id, s.name, s.category, s.created_at, s.updated_at
+		FROM skills s
+		JOIN work_skills ws ON s.id = ws.skill_id
+		WHERE ws.work_id IN (?)
+		ORDER BY ws.id
+	`, workIDs)
+	if err != nil {
+		return nil, failed("work skills", err)
+	}
+	defer rows.Close()
+
+	groups := make(map[string][]*model.Skill, len(workIDs))
+	for rows.Next() {
+		var workID string
+		skill := &model.Skill{}
+		if err := rows.Scan(&workID, &skill.ID, &skill.Name, &skill.Category, &skill.CreatedAt, &skill.UpdatedAt); err != nil {
+			return nil, failed("work skills", err)
+		}
+		groups[workID] = append(groups[workID], skill)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("work skills", err)
+	}
+	return groupByKey(workIDs, groups)
+}
+
+func (r *reader) eventsByWorkID(ctx context.Context, workIDs []string) ([][]*model.Event, []error) {
+	rows, err := r.query(ctx, `
+		SELECT we.work_id, e.id, e.name, e.description, e.start_date, e.end_date, e.location, e.created_at, e.updated_at, e.created_by, e.updated_by
+		FROM events e
+		JOIN work_events we ON e.id = we.event_id
+		WHERE we.work_id IN (?)
+		ORDER BY we.id
+	`, workIDs)
+	if err != nil {
+		return nil, failed("work events", err)
+	}
+	defer rows.Close()
+
+	groups := make(map[string][]*model.Event, len(workIDs))
+	for rows.Next() {
+		var workID string
+		event, err := scanEvent(rows, &workID)
+		if err != nil {
+			return nil, failed("work events", err)
+		}
+		groups[workID] = append(groups[workID], event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("work events", err)
+	}
+	return groupByKey(workIDs, groups)
+}
+
+func (r *reader) imagesByWorkID(ctx context.Context, workIDs []string) ([][]*model.Image, []error) {
+	rows, err := r.query(ctx, `
+		SELECT wi.work_id, i.id, i.image_url, i.created_at, i.updated_at
+		FROM images i
+		JOIN work_images wi ON i.id = wi.image_id
+		WHERE wi.work_id IN (?)
+		ORDER BY wi.id
+	`, workIDs)
+	if err != nil {
+		return nil, failed("work images", err)
+	}
+	defer rows.Close()
+
+	groups := make(map[string][]*model.Image, len(workIDs))
+	for rows.Next() {
+		var workID string
+		image := &model.Image{}
+		if err := rows.Scan(&workID, &image.ID, &image.ImageURL, &image.CreatedAt, &image.UpdatedAt); err != nil {
+			return nil, failed("work images", err)
+		}
+		groups[workID] = append(groups[workID], image)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("work images", err)
+	}
+	return groupByKey(workIDs, groups)
+}
+
+func (r *reader) diagramImagesByWorkID(ctx context.Context, workIDs []string) ([][]*model.DiagramImage, []error) {
+	rows, err := r.query(ctx, `
+		SELECT wdi.work_id, di.id, di.image_url, di.created_at, di.updated_at
+		FROM diagram_images di
+		JOIN work_diagram_images wdi ON di.id = wdi.image_id
+		WHERE wdi.work_id IN (?)
+		ORDER BY wdi.id
+	`, workIDs)
+	if err != nil {
+		return nil, failed("work diagram images", err)
+	}
+	defer rows.Close()
+
+	groups := make(map[string][]*model.DiagramImage, len(workIDs))
+	for rows.Next() {
+		var workID string
+		image := &model.DiagramImage{}
+		if err := rows.Scan(&workID, &image.ID, &image.ImageURL, &image.CreatedAt, &image.UpdatedAt); err != nil {
+			return nil, failed("work diagram images", err)
+		}
+		groups[workID] = append(groups[workID], image)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, failed("work diagram images", err)
+	}
+	return groupByKey(workIDs, groups)
+}
+
+// プロフィールの列を走査する
+// prefixには結合元のキーなど先頭に並ぶ列の格納先を渡す
+func scanProfile(rows *sqlx.Rows, prefix ...any) (*model.Profile, error) {
+	profile := &model.Profile{}
+	var avatarURL, nickName, affiliation, bio sql.NullString
+	var graduationYear sql.NullInt32
+
+	dest := append(prefix,
+		&profile.ID, &avatarURL, &nickName, &graduationYear, &affiliation, &bio,
+		&profile.CreatedAt, &profile.UpdatedAt,
+	)
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	profile.AvatarURL = avatarURL.String
+	profile.NickName = nickName.String
+	if graduationYear.Valid {
+		profile.GraduationYear = &graduationYear.Int32
+	}
+	if affiliation.Valid {
+		profile.Affiliation = &affiliation.String
+	}
+	if bio.Valid {
+		profile.Bio = &bio.String
+	}
+	return profile, nil
+}
+
+// イベントの列を走査する
+func scanEvent(rows *sqlx.Rows, prefix ...any) (*model.Event, error) {
+	event := &model.Event{}
+	dest := append(prefix,
+		&event.ID, &event.Name, &event.Description, &event.StartDate, &event.EndDate, &event.Location,
+		&event.CreatedAt, &event.UpdatedAt, &event.CreatedBy, &event.UpdatedBy,
+	)
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
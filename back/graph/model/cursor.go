@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
-	"log"
+	"log/slog"
 )
 
 // Cursor はページネーションのための情報を保持
@@ -24,13 +24,13 @@ func EncodeCursor(c Cursor) string {
 func DecodeCursor(encoded string) (*Cursor, error) {
 	data, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
-		log.Printf("failed to decode base64: %v", err)
+		slog.Error("failed to decode base64", "error", err)
 
 		return nil, fmt.Errorf("failed to decode base64")
 	}
 	var cursor Cursor
 	if err := json.Unmarshal(data, &cursor); err != nil {
-		log.Printf("failed to unmarshal cursor JSON: %v", err)
+		slog.Error("failed to unmarshal cursor JSON", "error", err)
 		
 		return nil, fmt.Errorf("failed to unmarshal cursor JSON")
 	}
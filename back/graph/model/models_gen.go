@@ -2,6 +2,56 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type AuditLog struct {
+	ID         string         `json:"id"`
+	ActorID    *string        `json:"actorId,omitempty"`
+	Action     string         `json:"action"`
+	TargetType *string        `json:"targetType,omitempty"`
+	TargetID   *string        `json:"targetId,omitempty"`
+	Changes    map[string]any `json:"changes,omitempty"`
+	IPAddress  *string        `json:"ipAddress,omitempty"`
+	UserAgent  *string        `json:"userAgent,omitempty"`
+	CreatedAt  string         `json:"createdAt"`
+}
+
+type AuditLogConnection struct {
+	Edges    []*AuditLogEdge `json:"edges"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type AuditLogEdge struct {
+	Node   *AuditLog `json:"node"`
+	Cursor string    `json:"cursor"`
+}
+
+type AuditLogFilter struct {
+	ActorID    *string `json:"actorId,omitempty"`
+	Action     *string `json:"action,omitempty"`
+	TargetType *string `json:"targetType,omitempty"`
+	TargetID   *string `json:"targetId,omitempty"`
+	From       *string `json:"from,omitempty"`
+	To         *string `json:"to,omitempty"`
+}
+
+type CardPrivacySettings struct {
+	AvatarURL      bool `json:"avatarUrl"`
+	GraduationYear bool `json:"graduationYear"`
+	Affiliation    bool `json:"affiliation"`
+	Bio            bool `json:"bio"`
+}
+
+type CardScan struct {
+	WorkProfile *WorkProfile `json:"workProfile"`
+	ScannedAt   string       `json:"scannedAt"`
+}
+
 type Mutation struct {
 }
 
@@ -22,8 +72,12 @@ type NewEvent struct {
 	StartDate   string `json:"startDate"`
 	EndDate     string `json:"endDate"`
 	Location    string `json:"location"`
-	CreatedBy   string `json:"createdBy"`
-	UpdatedBy   string `json:"updatedBy"`
+}
+
+type NewPersonalAccessToken struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays *int32   `json:"expiresInDays,omitempty"`
 }
 
 type NewProfile struct {
@@ -75,9 +129,47 @@ type NewWorkSkill struct {
 	SkillID string `json:"skillId"`
 }
 
+type PrivacySettings struct {
+	Email          Visibility           `json:"email"`
+	Name           Visibility           `json:"name"`
+	AvatarURL      Visibility           `json:"avatarUrl"`
+	GraduationYear Visibility           `json:"graduationYear"`
+	Affiliation    Visibility           `json:"affiliation"`
+	Bio            Visibility           `json:"bio"`
+	Card           *CardPrivacySettings `json:"card"`
+}
+
 type Query struct {
 }
 
+type Subscription struct {
+}
+
+type UpdateCardPrivacySettings struct {
+	AvatarURL      *bool `json:"avatarUrl,omitempty"`
+	GraduationYear *bool `json:"graduationYear,omitempty"`
+	Affiliation    *bool `json:"affiliation,omitempty"`
+	Bio            *bool `json:"bio,omitempty"`
+}
+
+type UpdateEvent struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	StartDate   *string `json:"startDate,omitempty"`
+	EndDate     *string `json:"endDate,omitempty"`
+	Location    *string `json:"location,omitempty"`
+}
+
+type UpdatePrivacySettings struct {
+	Email          *Visibility                `json:"email,omitempty"`
+	Name           *Visibility                `json:"name,omitempty"`
+	AvatarURL      *Visibility                `json:"avatarUrl,omitempty"`
+	GraduationYear *Visibility                `json:"graduationYear,omitempty"`
+	Affiliation    *Visibility                `json:"affiliation,omitempty"`
+	Bio            *Visibility                `json:"bio,omitempty"`
+	Card           *UpdateCardPrivacySettings `json:"card,omitempty"`
+}
+
 type UpdateProfile struct {
 	ID             string  `json:"id"`
 	AvatarURL      *string `json:"avatarUrl,omitempty"`
@@ -87,6 +179,11 @@ type UpdateProfile struct {
 	Bio            *string `json:"bio,omitempty"`
 }
 
+type UpdateSkill struct {
+	Name     *string `json:"name,omitempty"`
+	Category *string `json:"category,omitempty"`
+}
+
 type UpdateWork struct {
 	Title           *string   `json:"title,omitempty"`
 	Description     *string   `json:"description,omitempty"`
@@ -95,3 +192,184 @@ type UpdateWork struct {
 	ImageURL        []*string `json:"imageUrl,omitempty"`
 	DiagramImageURL []*string `json:"diagramImageUrl,omitempty"`
 }
+
+type PrivacyField string
+
+const (
+	PrivacyFieldEmail          PrivacyField = "EMAIL"
+	PrivacyFieldName           PrivacyField = "NAME"
+	PrivacyFieldAvatarURL      PrivacyField = "AVATAR_URL"
+	PrivacyFieldGraduationYear PrivacyField = "GRADUATION_YEAR"
+	PrivacyFieldAffiliation    PrivacyField = "AFFILIATION"
+	PrivacyFieldBio            PrivacyField = "BIO"
+)
+
+var AllPrivacyField = []PrivacyField{
+	PrivacyFieldEmail,
+	PrivacyFieldName,
+	PrivacyFieldAvatarURL,
+	PrivacyFieldGraduationYear,
+	PrivacyFieldAffiliation,
+	PrivacyFieldBio,
+}
+
+func (e PrivacyField) IsValid() bool {
+	switch e {
+	case PrivacyFieldEmail, PrivacyFieldName, PrivacyFieldAvatarURL, PrivacyFieldGraduationYear, PrivacyFieldAffiliation, PrivacyFieldBio:
+		return true
+	}
+	return false
+}
+
+func (e PrivacyField) String() string {
+	return string(e)
+}
+
+func (e *PrivacyField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PrivacyField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PrivacyField", str)
+	}
+	return nil
+}
+
+func (e PrivacyField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PrivacyField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PrivacyField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Role string
+
+const (
+	RoleUser      Role = "USER"
+	RoleOrganizer Role = "ORGANIZER"
+	RoleModerator Role = "MODERATOR"
+	RoleAdmin     Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleUser,
+	RoleOrganizer,
+	RoleModerator,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUser, RoleOrganizer, RoleModerator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Visibility string
+
+const (
+	VisibilityPublic          Visibility = "PUBLIC"
+	VisibilityConnections     Visibility = "CONNECTIONS"
+	VisibilityEventOrganizers Visibility = "EVENT_ORGANIZERS"
+	VisibilitySelf            Visibility = "SELF"
+)
+
+var AllVisibility = []Visibility{
+	VisibilityPublic,
+	VisibilityConnections,
+	VisibilityEventOrganizers,
+	VisibilitySelf,
+}
+
+func (e Visibility) IsValid() bool {
+	switch e {
+	case VisibilityPublic, VisibilityConnections, VisibilityEventOrganizers, VisibilitySelf:
+		return true
+	}
+	return false
+}
+
+func (e Visibility) String() string {
+	return string(e)
+}
+
+func (e *Visibility) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Visibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Visibility", str)
+	}
+	return nil
+}
+
+func (e Visibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Visibility) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Visibility) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
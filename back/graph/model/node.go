@@ -0,0 +1,63 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// 型名とキーをまとめた、全ての型で一意なID（Relayのグローバルオブジェクト識別）を持つ型
+// クライアントはnodeIdをキャッシュの正規化と node / nodes での再取得に使う
+type Node interface {
+	NodeID() string
+}
+
+// グローバルIDの型名
+const (
+	NodeTypeWork         = "Work"
+	NodeTypeProfile      = "Profile"
+	NodeTypeUser         = "User"
+	NodeTypeEvent        = "Event"
+	NodeTypeSkill        = "Skill"
+	NodeTypeWorkProfile  = "WorkProfile"
+	NodeTypeWorkSkill    = "WorkSkill"
+	NodeTypeWorkEvent    = "WorkEvent"
+	NodeTypeProfileSkill = "ProfileSkill"
+)
+
+var ErrInvalidGlobalID = errors.New("invalid global id")
+
+// EncodeGlobalID は「型名:キー」を Base64 エンコードする
+func EncodeGlobalID(typeName, key string) string {
+	return base64.StdEncoding.EncodeToString([]byte(typeName + ":" + key))
+}
+
+// DecodeGlobalID は EncodeGlobalID で作ったIDを型名とキーに戻す
+func DecodeGlobalID(id string) (typeName, key string, err error) {
+	data, err := base64.StdEncoding.DecodeString(id)
+	if err != nil {
+		return "", "", ErrInvalidGlobalID
+	}
+	typeName, key, ok := strings.Cut(string(data), ":")
+	if !ok || typeName == "" || key == "" {
+		return "", "", ErrInvalidGlobalID
+	}
+	return typeName, key, nil
+}
+
+func (w Work) NodeID() string         { return EncodeGlobalID(NodeTypeWork, w.ID) }
+func (p Profile) NodeID() string      { return EncodeGlobalID(NodeTypeProfile, p.ID) }
+func (u User) NodeID() string         { return EncodeGlobalID(NodeTypeUser, u.ID) }
+func (e Event) NodeID() string        { return EncodeGlobalID(NodeTypeEvent, e.ID) }
+func (s Skill) NodeID() string        { return EncodeGlobalID(NodeTypeSkill, s.ID) }
+func (wp WorkProfile) NodeID() string { return EncodeGlobalID(NodeTypeWorkProfile, wp.ID) }
+func (we WorkEvent) NodeID() string   { return EncodeGlobalID(NodeTypeWorkEvent, we.ID) }
+
+func (ws WorkSkill) NodeID() string {
+	return EncodeGlobalID(NodeTypeWorkSkill, strconv.Itoa(int(ws.ID)))
+}
+
+func (ps ProfileSkill) NodeID() string {
+	return EncodeGlobalID(NodeTypeProfileSkill, strconv.Itoa(int(ps.ID)))
+}
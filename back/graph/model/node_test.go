@@ -0,0 +1,69 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestGlobalIDRoundTrip(t *testing.T) {
+	tests := []struct {
+		typeName string
+		key      string
+	}{
+		{NodeTypeWork, "0b8f7a1e-3c2d-4e5f-8a9b-1c2d3e4f5a6b"},
+		{NodeTypeWorkSkill, "42"},
+		// キーに区切り文字を含む場合も最初の「:」で分ける
+		{NodeTypeProfile, "a:b:c"},
+		{NodeTypeEvent, "イベント"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName+"/"+tt.key, func(t *testing.T) {
+			typeName, key, err := DecodeGlobalID(EncodeGlobalID(tt.typeName, tt.key))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if typeName != tt.typeName || key != tt.key {
+				t.Errorf("decoded (%q, %q), want (%q, %q)", typeName, key, tt.typeName, tt.key)
+			}
+		})
+	}
+}
+
+func TestDecodeGlobalIDInvalid(t *testing.T) {
+	encode := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{"empty", ""},
+		{"not base64", "!!!"},
+		{"url-safe alphabet", base64.URLEncoding.EncodeToString([]byte("Work:??>"))},
+		{"no separator", encode("Work")},
+		{"empty type", encode(":key")},
+		{"empty key", encode("Work:")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := DecodeGlobalID(tt.id); !errors.Is(err, ErrInvalidGlobalID) {
+				t.Errorf("DecodeGlobalID(%q) err = %v, want ErrInvalidGlobalID", tt.id, err)
+			}
+		})
+	}
+}
+
+func TestNodeID(t *testing.T) {
+	tests := []struct {
+		node Node
+		want string
+	}{
+		{Work{ID: "w1"}, EncodeGlobalID(NodeTypeWork, "w1")},
+		{WorkSkill{ID: 7}, EncodeGlobalID(NodeTypeWorkSkill, "7")},
+		{ProfileSkill{ID: 8}, EncodeGlobalID(NodeTypeProfileSkill, "8")},
+	}
+	for _, tt := range tests {
+		if got := tt.node.NodeID(); got != tt.want {
+			t.Errorf("%T.NodeID() = %q, want %q", tt.node, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+type PersonalAccessToken struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	Name       string     `json:"name"`
+	TokenHash  string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreatedPersonalAccessToken struct {
+	Token               string               `json:"token"`
+	PersonalAccessToken *PersonalAccessToken `json:"personal_access_token"`
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/apperror"
+)
+
+// 複数の項目にまたがる入力の検証（directive.InputValidator）
+// 各項目の形式・長さはスキーマのディレクティブで検証するため、ここでは扱わない
+
+const inputDateLayout = "2006-01-02 15:04:05"
+
+func (e NewEvent) ValidateInput(path string) []apperror.FieldError {
+	return validatePeriod(path, &e.StartDate, &e.EndDate)
+}
+
+func (e UpdateEvent) ValidateInput(path string) []apperror.FieldError {
+	return validatePeriod(path, e.StartDate, e.EndDate)
+}
+
+// 変更後の期間が正しいか（未指定の日時は保存済みのイベントの値を使う）
+func (e UpdateEvent) ValidatePeriod(path string, stored *Event) []apperror.FieldError {
+	start, end := stored.StartDate.Format(inputDateLayout), stored.EndDate.Format(inputDateLayout)
+	if e.StartDate != nil {
+		start = *e.StartDate
+	}
+	if e.EndDate != nil {
+		end = *e.EndDate
+	}
+	return validatePeriod(path, &start, &end)
+}
+
+func (w NewWork) ValidateInput(path string) []apperror.FieldError {
+	var violations []apperror.FieldError
+	violations = append(violations, duplicates(path+".userIds", w.UserIds)...)
+	violations = append(violations, duplicates(path+".skills", w.Skills)...)
+	return violations
+}
+
+func (w UpdateWork) ValidateInput(path string) []apperror.FieldError {
+	var violations []apperror.FieldError
+	violations = append(violations, duplicates(path+".userIds", w.UserIds)...)
+	violations = append(violations, duplicates(path+".skills", w.Skills)...)
+	return violations
+}
+
+func (e NewCreateProjectEvent) ValidateInput(path string) []apperror.FieldError {
+	var violations []apperror.FieldError
+	// 既存の作品をイベントに登録する場合はイベントが必要
+	if e.WorkID != nil && e.EventID == nil {
+		violations = append(violations, apperror.Field(path+".eventId", "イベントが提供されていません。"))
+	}
+	violations = append(violations, duplicates(path+".userIds", e.UserIds)...)
+	violations = append(violations, duplicates(path+".skills", e.Skills)...)
+	return violations
+}
+
+// 終了日時が開始日時より前になっていないか（どちらかが未指定・形式が不正な場合は確認しない）
+func validatePeriod(path string, startDate, endDate *string) []apperror.FieldError {
+	if startDate == nil || endDate == nil {
+		return nil
+	}
+	start, err := time.Parse(inputDateLayout, *startDate)
+	if err != nil {
+		return nil
+	}
+	end, err := time.Parse(inputDateLayout, *endDate)
+	if err != nil {
+		return nil
+	}
+	if end.Before(start) {
+		return []apperror.FieldError{apperror.Field(path+".endDate", "終了日時は開始日時以降にしてください。")}
+	}
+	return nil
+}
+
+// 重複した要素（2つ目以降、nullは対象外）
+func duplicates[T string | *string](path string, values []T) []apperror.FieldError {
+	var violations []apperror.FieldError
+	seen := make(map[string]struct{}, len(values))
+	for i, value := range values {
+		var v string
+		switch x := any(value).(type) {
+		case string:
+			v = x
+		case *string:
+			if x == nil {
+				continue
+			}
+			v = *x
+		}
+		if _, ok := seen[v]; ok {
+			violations = append(violations, apperror.Field(fmt.Sprintf("%s.%d", path, i), "同じ値が重複しています。"))
+			continue
+		}
+		seen[v] = struct{}{}
+	}
+	return violations
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/apperror"
+)
+
+func ptr(s string) *string { return &s }
+
+// 違反した項目のパス
+func violationPaths(violations []apperror.FieldError) []string {
+	var paths []string
+	for _, v := range violations {
+		paths = append(paths, v.Path)
+	}
+	return paths
+}
+
+func TestValidatePeriod(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end *string
+		want       []string
+	}{
+		{"valid", ptr("2025-04-01 10:00:00"), ptr("2025-04-02 18:00:00"), nil},
+		{"same time", ptr("2025-04-01 10:00:00"), ptr("2025-04-01 10:00:00"), nil},
+		{"end before start", ptr("2025-04-02 10:00:00"), ptr("2025-04-01 10:00:00"), []string{"input.endDate"}},
+		{"end one second before start", ptr("2025-04-01 10:00:00"), ptr("2025-04-01 09:59:59"), []string{"input.endDate"}},
+		// 片方が未指定・形式が不正な場合は他の検証に任せる
+		{"start only", ptr("2025-04-02 10:00:00"), nil, nil},
+		{"end only", nil, ptr("2025-04-01 10:00:00"), nil},
+		{"invalid start", ptr("2025/04/02"), ptr("2025-04-01 10:00:00"), nil},
+		{"invalid end", ptr("2025-04-02 10:00:00"), ptr("tomorrow"), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violationPaths(validatePeriod("input", tt.start, tt.end)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateEventValidatePeriod(t *testing.T) {
+	stored := &Event{
+		StartDate: time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 4, 2, 18, 0, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		name  string
+		input UpdateEvent
+		want  []string
+	}{
+		{"start before stored end", UpdateEvent{StartDate: ptr("2025-04-02 09:00:00")}, nil},
+		{"start after stored end", UpdateEvent{StartDate: ptr("2025-04-03 09:00:00")}, []string{"input.endDate"}},
+		{"end after stored start", UpdateEvent{EndDate: ptr("2025-04-01 12:00:00")}, nil},
+		{"end before stored start", UpdateEvent{EndDate: ptr("2025-03-31 12:00:00")}, []string{"input.endDate"}},
+		// 両方を指定した場合は保存済みの値を使わない
+		{"both", UpdateEvent{StartDate: ptr("2025-05-01 10:00:00"), EndDate: ptr("2025-05-02 10:00:00")}, nil},
+		{"neither", UpdateEvent{Name: ptr("renamed")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violationPaths(tt.input.ValidatePeriod("input", stored)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	if got := violationPaths(duplicates("input.userIds", []string{"a", "b", "a", "c", "b", "a"})); !reflect.DeepEqual(got, []string{"input.userIds.2", "input.userIds.4", "input.userIds.5"}) {
+		t.Errorf("duplicates() = %v", got)
+	}
+	if got := duplicates("input.userIds", []string{"a", "b"}); got != nil {
+		t.Errorf("duplicates() = %v, want none", violationPaths(got))
+	}
+	// nullは重複として扱わない
+	if got := violationPaths(duplicates("input.diagramImageUrl", []*string{nil, ptr("x"), nil, ptr("x")})); !reflect.DeepEqual(got, []string{"input.diagramImageUrl.3"}) {
+		t.Errorf("duplicates() = %v", got)
+	}
+	if got := duplicates[string]("input.skills", nil); got != nil {
+		t.Errorf("duplicates(nil) = %v", violationPaths(got))
+	}
+}
+
+func TestValidateInput(t *testing.T) {
+	workID := "w1"
+	tests := []struct {
+		name  string
+		input interface {
+			ValidateInput(path string) []apperror.FieldError
+		}
+		want []string
+	}{
+		{"new event", NewEvent{StartDate: "2025-04-02 10:00:00", EndDate: "2025-04-01 10:00:00"}, []string{"input.endDate"}},
+		{"update event", UpdateEvent{StartDate: ptr("2025-04-02 10:00:00"), EndDate: ptr("2025-04-01 10:00:00")}, []string{"input.endDate"}},
+		{"new work", NewWork{UserIds: []string{"u1", "u1"}, Skills: []string{"go", "go"}}, []string{"input.userIds.1", "input.skills.1"}},
+		{"update work", UpdateWork{Skills: []*string{ptr("go"), ptr("ts"), ptr("go")}}, []string{"input.skills.2"}},
+		{"existing work without event", NewCreateProjectEvent{WorkID: &workID}, []string{"input.eventId"}},
+		{"project event", NewCreateProjectEvent{UserIds: []string{"u1", "u2"}, Skills: []string{"go"}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := violationPaths(tt.input.ValidateInput("input")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("violations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
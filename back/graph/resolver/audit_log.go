@@ -0,0 +1,92 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+// ドメインの監査ログをGraphQLの監査ログに変換
+func auditLogToGraph(l *domainModel.AuditLog) *model.AuditLog {
+	var changes map[string]interface{}
+	if len(l.Changes) > 0 {
+		if err := json.Unmarshal(l.Changes, &changes); err != nil {
+			slog.Error("failed to unmarshal audit log changes", "error", err)
+		}
+	}
+
+	return &model.AuditLog{
+		ID:         strconv.FormatInt(l.ID, 10),
+		ActorID:    stringPtr(l.ActorID),
+		Action:     l.Action,
+		TargetType: stringPtr(l.TargetType),
+		TargetID:   stringPtr(l.TargetID),
+		Changes:    changes,
+		IPAddress:  stringPtr(l.IPAddress),
+		UserAgent:  stringPtr(l.UserAgent),
+		CreatedAt:  l.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// 空文字はnullとして返す
+func stringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// 監査ログの変更前後の値を取得する方法（ミューテーションの戻り値の型名ごと）
+func (r *Resolver) AuditTargetLoaders() map[string]directive.AuditTargetLoader {
+	return map[string]directive.AuditTargetLoader{
+		"User": func(ctx context.Context, id string) (interface{}, error) {
+			user, err := r.Query().UserByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			// 権限はフィールドリゾルバーで取得するため、変更を記録できるよう併せて取得する
+			role, err := r.Roles.GetRole(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return struct {
+				*model.User
+				Role domainModel.Role `json:"role"`
+			}{user, role}, nil
+		},
+		"Profile": func(ctx context.Context, id string) (interface{}, error) {
+			return r.Query().Profile(ctx, id)
+		},
+		"Work": func(ctx context.Context, id string) (interface{}, error) {
+			return r.Query().Work(ctx, id)
+		},
+		"WorkProfile": func(ctx context.Context, id string) (interface{}, error) {
+			return r.Query().WorkProfile(ctx, id)
+		},
+		"Event": func(ctx context.Context, id string) (interface{}, error) {
+			return r.Query().EventByID(ctx, id)
+		},
+		"Skill": func(ctx context.Context, id string) (interface{}, error) {
+			return r.skillByID(ctx, id)
+		},
+		"ProfileSkill": func(ctx context.Context, id string) (interface{}, error) {
+			profileSkillID, err := strconv.ParseInt(id, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			return r.Query().ProfileSkill(ctx, int32(profileSkillID))
+		},
+	}
+}
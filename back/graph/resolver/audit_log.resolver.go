@@ -0,0 +1,100 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+// AuditLogs is the resolver for the auditLogs field.
+func (r *queryResolver) AuditLogs(ctx context.Context, filter *model.AuditLogFilter, first *int32, after *string) (*model.AuditLogConnection, error) {
+	var domainFilter domainModel.AuditLogFilter
+	if filter != nil {
+		domainFilter = domainModel.AuditLogFilter{
+			ActorID:    stringValue(filter.ActorID),
+			Action:     stringValue(filter.Action),
+			TargetType: stringValue(filter.TargetType),
+			TargetID:   stringValue(filter.TargetID),
+		}
+		var invalid []apperror.FieldError
+		for _, t := range []struct {
+			path  string
+			value *string
+			dst   **time.Time
+		}{
+			{"filter.from", filter.From, &domainFilter.From},
+			{"filter.to", filter.To, &domainFilter.To},
+		} {
+			if t.value == nil || *t.value == "" {
+				continue
+			}
+			parsed, err := time.Parse("2006-01-02 15:04:05", *t.value)
+			if err != nil {
+				invalid = append(invalid, apperror.Field(t.path, "日時の形式が不正です。"))
+				continue
+			}
+			*t.dst = &parsed
+		}
+		if len(invalid) > 0 {
+			return nil, apperror.Validation(invalid...)
+		}
+	}
+
+	var beforeID int64
+	if after != nil && *after != "" {
+		cursor, err := model.DecodeCursor(*after)
+		if err == nil {
+			beforeID, err = strconv.ParseInt(cursor.ID, 10, 64)
+		}
+		if err != nil {
+			return nil, apperror.Invalid("after", "無効なafterカーソルです。")
+		}
+	}
+
+	limit := 0
+	if first != nil {
+		limit = int(*first)
+		if err := r.checkPageSize("first", limit); err != nil {
+			return nil, err
+		}
+	}
+
+	page, err := r.Audit.List(ctx, domainFilter, beforeID, limit)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list audit logs", "error", err)
+
+		return nil, apperror.Internal("監査ログの取得中にサーバーエラーが発生しました。")
+	}
+
+	connection := &model.AuditLogConnection{
+		Edges:    []*model.AuditLogEdge{},
+		PageInfo: &model.PageInfo{HasNextPage: page.HasNextPage, HasPreviousPage: beforeID > 0},
+	}
+	for _, l := range page.Logs {
+		cursor := model.EncodeCursor(model.Cursor{CreatedAt: l.CreatedAt, ID: strconv.FormatInt(l.ID, 10)})
+		connection.Edges = append(connection.Edges, &model.AuditLogEdge{
+			Node:   auditLogToGraph(l),
+			Cursor: cursor,
+		})
+	}
+	if n := len(connection.Edges); n > 0 {
+		connection.PageInfo.StartCursor = &connection.Edges[0].Cursor
+		connection.PageInfo.EndCursor = &connection.Edges[n-1].Cursor
+	}
+	return connection, nil
+}
+
+// Query returns graph.QueryResolver implementation.
+func (r *Resolver) Query() graph.QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
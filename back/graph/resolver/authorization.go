@@ -0,0 +1,49 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+// 認証済みユーザーに対して権限チェックを行い、結果をGraphQLのエラーに変換する
+func (r *Resolver) authorize(ctx context.Context, check func(userID string) error) error {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return directive.Unauthenticated()
+	}
+
+	err := check(viewer.UserID)
+	if err == nil {
+		return nil
+	}
+
+	var denied *usecase.PermissionDeniedError
+	switch {
+	case errors.As(err, &denied):
+		return directive.Forbidden(denied.Reason)
+	case errors.Is(err, usecase.ErrResourceNotFound):
+		return apperror.NotFound("操作対象が見つかりません。")
+	default:
+		slog.ErrorContext(ctx, "failed to check permission", "error", err)
+
+		return apperror.Internal("権限の確認中にサーバーエラーが発生しました。")
+	}
+}
+
+// 新規作成する作品のメンバーに認証済みユーザーが含まれているか確認する
+func (r *Resolver) authorizeNewWorkMembers(ctx context.Context, userIDs []string) error {
+	return r.authorize(ctx, func(userID string) error {
+		for _, id := range userIDs {
+			if id == userID {
+				return nil
+			}
+		}
+		return &usecase.PermissionDeniedError{Reason: usecase.DenyReasonNotWorkMember}
+	})
+}
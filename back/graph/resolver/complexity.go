@@ -0,0 +1,79 @@
+package resolver
+
+import (
+	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+const (
+	// workListで件数を指定しなかった場合の件数
+	defaultWorkPageSize = 3
+	// 件数を指定できないリストで想定する件数
+	estimatedListSize = 10
+	// 作品に紐づく関連（メンバー・スキル・イベント）で想定する件数
+	estimatedRelationSize = 5
+)
+
+// リストを返すフィールドの複雑度を件数に比例させる
+// 指定がないフィールドはgqlgenの既定（子の複雑度+1）になる
+func Complexity() graph.ComplexityRoot {
+	var c graph.ComplexityRoot
+
+	c.Query.WorkList = func(childComplexity int, first *int32, after *string, last *int32, before *string) int {
+		n := defaultWorkPageSize
+		if first != nil {
+			n = int(*first)
+		} else if last != nil {
+			n = int(*last)
+		}
+		return listComplexity(n, childComplexity)
+	}
+	c.Query.AuditLogs = func(childComplexity int, filter *model.AuditLogFilter, first *int32, after *string) int {
+		n := usecase.DefaultAuditLogPageSize
+		if first != nil && *first > 0 {
+			n = min(int(*first), usecase.MaxAuditLogPageSize)
+		}
+		return listComplexity(n, childComplexity)
+	}
+
+	c.Query.Nodes = func(childComplexity int, ids []string) int {
+		return listComplexity(len(ids), childComplexity)
+	}
+
+	list := func(childComplexity int) int {
+		return listComplexity(estimatedListSize, childComplexity)
+	}
+	c.Query.Events = list
+	c.Query.Skills = list
+	c.Query.Users = list
+	c.Query.MyPersonalAccessTokens = list
+	c.Query.MyProviders = list
+	c.Query.MySessions = list
+	c.Query.ProfileByNickName = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.ProfileSkillsByProfileID = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.WorksByTitle = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.WorksByProfileID = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.WorkProfilesByWorkID = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.WorkProfilesByProfileID = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.WorkEventsByWorkID = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.WorkEventsByEventID = func(childComplexity int, _ string) int { return list(childComplexity) }
+	c.Query.WorkSkillsByWorkID = func(childComplexity int, _ string) int { return list(childComplexity) }
+
+	relation := func(childComplexity int) int {
+		return listComplexity(estimatedRelationSize, childComplexity)
+	}
+	c.Work.Profile = relation
+	c.Work.Skills = relation
+	c.Work.Event = relation
+	c.WorkEvent.Works = relation
+
+	return c
+}
+
+func listComplexity(n, childComplexity int) int {
+	if n < 1 {
+		n = 1
+	}
+	return 1 + n*childComplexity
+}
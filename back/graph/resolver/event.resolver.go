@@ -8,13 +8,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/directive"
 	"github.com/noonyuu/nfc/back/graph/model"
-	"github.com/vektah/gqlparser/gqlerror"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
 )
 
 // StartDate is the resolver for the startDate field.
@@ -39,6 +42,11 @@ func (r *eventResolver) UpdatedAt(ctx context.Context, obj *model.Event) (string
 
 // CreateEvent is the resolver for the createEvent field.
 func (r *mutationResolver) CreateEvent(ctx context.Context, input model.NewEvent) (*model.Event, error) {
+	// 作成者は認証済みユーザーとする
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
 	// uuidを生成
 	uid, _ := uuid.NewRandom()
 	// 生成したUUIDを文字列に変換
@@ -48,25 +56,15 @@ func (r *mutationResolver) CreateEvent(ctx context.Context, input model.NewEvent
 	// startとendのデータ型を変換
 	startDate, err := time.Parse("2006-01-02 15:04:05", input.StartDate)
 	if err != nil {
-		log.Printf("failed to parse startDate: %v", err)
+		slog.ErrorContext(ctx, "failed to parse startDate", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "日付の形式が正しくありません。'YYYY-MM-DD HH:MM:SS' の形式で入力してください。",
-			Extensions: map[string]interface{}{
-				"code": "BAD_USER_INPUT",
-			},
-		}
+		return nil, apperror.Invalid("input.startDate", "日付の形式が正しくありません。'YYYY-MM-DD HH:MM:SS' の形式で入力してください。")
 	}
 	endDate, err := time.Parse("2006-01-02 15:04:05", input.EndDate)
 	if err != nil {
-		log.Printf("failed to parse startDate: %v", err)
+		slog.ErrorContext(ctx, "failed to parse startDate", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "日付の形式が正しくありません。'YYYY-MM-DD HH:MM:SS' の形式で入力してください。",
-			Extensions: map[string]interface{}{
-				"code": "BAD_USER_INPUT",
-			},
-		}
+		return nil, apperror.Invalid("input.endDate", "日付の形式が正しくありません。'YYYY-MM-DD HH:MM:SS' の形式で入力してください。")
 	}
 	// Event構造体にUUIDと現在時刻をセット
 	event := &model.Event{
@@ -78,8 +76,8 @@ func (r *mutationResolver) CreateEvent(ctx context.Context, input model.NewEvent
 		Location:    input.Location,
 		CreatedAt:   now,
 		UpdatedAt:   now,
-		CreatedBy:   input.CreatedBy,
-		UpdatedBy:   input.CreatedBy,
+		CreatedBy:   viewer.UserID,
+		UpdatedBy:   viewer.UserID,
 	}
 
 	query := `
@@ -88,17 +86,167 @@ func (r *mutationResolver) CreateEvent(ctx context.Context, input model.NewEvent
 	`
 	_, err = r.DB.Exec(query, event.ID, event.Name, event.Description, event.StartDate, event.EndDate, event.Location, now, now, event.CreatedBy, event.UpdatedBy)
 	if err != nil {
-		log.Printf("failed to insert event: %v", err)
+		slog.ErrorContext(ctx, "failed to insert event", "error", err)
 
 		// その他のデータベースエラーの場合は、汎用的なメッセージを返す
-		return nil, &gqlerror.Error{
-			Message: "イベントの作成中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
+		return nil, apperror.Internal("イベントの作成中にサーバーエラーが発生しました。")
+	}
+
+	return event, nil
+}
+
+// UpdateEvent is the resolver for the updateEvent field.
+func (r *mutationResolver) UpdateEvent(ctx context.Context, id string, input model.UpdateEvent) (*model.Event, error) {
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Authz.CanEditEvent(ctx, userID, id)
+	}); err != nil {
+		return nil, err
+	}
+
+	// 開始日時・終了日時の片方だけを変更する場合は、保存済みのもう片方と比べる
+	if (input.StartDate == nil) != (input.EndDate == nil) {
+		stored, err := r.Query().EventByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if violations := input.ValidatePeriod("input", stored); len(violations) > 0 {
+			return nil, apperror.Validation(violations...)
+		}
+	}
+
+	// 動的にUPDATE文を構築
+	var setParts []string
+	var args []interface{}
+
+	if input.Name != nil {
+		setParts = append(setParts, "name = ?")
+		args = append(args, *input.Name)
+	}
+	if input.Description != nil {
+		setParts = append(setParts, "description = ?")
+		args = append(args, *input.Description)
+	}
+	if input.StartDate != nil {
+		startDate, err := time.Parse("2006-01-02 15:04:05", *input.StartDate)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to parse startDate", "error", err)
+
+			return nil, apperror.Invalid("input.startDate", "日付の形式が正しくありません。'YYYY-MM-DD HH:MM:SS' の形式で入力してください。")
+		}
+		setParts = append(setParts, "start_date = ?")
+		args = append(args, startDate)
+	}
+	if input.EndDate != nil {
+		endDate, err := time.Parse("2006-01-02 15:04:05", *input.EndDate)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to parse endDate", "error", err)
+
+			return nil, apperror.Invalid("input.endDate", "日付の形式が正しくありません。'YYYY-MM-DD HH:MM:SS' の形式で入力してください。")
+		}
+		setParts = append(setParts, "end_date = ?")
+		args = append(args, endDate)
+	}
+	if input.Location != nil {
+		setParts = append(setParts, "location = ?")
+		args = append(args, *input.Location)
+	}
+
+	// 更新するフィールドがない場合
+	if len(setParts) == 0 {
+		return nil, apperror.Invalid("input", "更新するフィールドが指定されていません。")
+	}
+
+	// updated_at・updated_byは常に更新
+	viewer := auth.ViewerFromContext(ctx)
+	setParts = append(setParts, "updated_at = ?", "updated_by = ?")
+	args = append(args, time.Now(), viewer.UserID, id)
+
+	updateQuery := fmt.Sprintf("UPDATE events SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	if _, err := r.DB.ExecContext(ctx, updateQuery, args...); err != nil {
+		slog.ErrorContext(ctx, "failed to update event", "error", err)
+
+		return nil, apperror.Internal("イベントの更新中にサーバーエラーが発生しました。")
+	}
+
+	return r.Query().EventByID(ctx, id)
+}
+
+// AddEventOrganizer is the resolver for the addEventOrganizer field.
+func (r *mutationResolver) AddEventOrganizer(ctx context.Context, eventID string, userID string) (*model.Event, error) {
+	if err := r.authorize(ctx, func(viewerID string) error {
+		return r.Authz.CanManageEventOrganizers(ctx, viewerID, eventID)
+	}); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	query := `
+		INSERT IGNORE INTO event_organizers (event_id, user_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?)
+	`
+	if _, err := r.DB.ExecContext(ctx, query, eventID, userID, now, now); err != nil {
+		slog.ErrorContext(ctx, "failed to insert event organizer", "error", err)
+
+		return nil, apperror.Internal("運営者の追加中にサーバーエラーが発生しました。")
+	}
+
+	return r.Query().EventByID(ctx, eventID)
+}
+
+// RemoveEventOrganizer is the resolver for the removeEventOrganizer field.
+func (r *mutationResolver) RemoveEventOrganizer(ctx context.Context, eventID string, userID string) (*model.Event, error) {
+	if err := r.authorize(ctx, func(viewerID string) error {
+		return r.Authz.CanManageEventOrganizers(ctx, viewerID, eventID)
+	}); err != nil {
+		return nil, err
+	}
+
+	query := `
+		DELETE FROM event_organizers WHERE event_id = ? AND user_id = ?
+	`
+	if _, err := r.DB.ExecContext(ctx, query, eventID, userID); err != nil {
+		slog.ErrorContext(ctx, "failed to delete event organizer", "error", err)
+
+		return nil, apperror.Internal("運営者の削除中にサーバーエラーが発生しました。")
+	}
+
+	return r.Query().EventByID(ctx, eventID)
+}
+
+// DeleteEvent is the resolver for the deleteEvent field.
+func (r *mutationResolver) DeleteEvent(ctx context.Context, id string) (*model.Event, error) {
+	event, err := r.Query().EventByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// イベントに紐づく作品の登録・運営者も削除する
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+
+		return nil, apperror.Internal("イベントの削除中にサーバーエラーが発生しました。")
+	}
+	defer tx.Rollback()
+
+	for _, query := range []string{
+		"DELETE FROM work_events WHERE event_id = ?",
+		"DELETE FROM event_organizers WHERE event_id = ?",
+		"DELETE FROM events WHERE id = ?",
+	} {
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			slog.ErrorContext(ctx, "failed to delete event", "error", err)
+
+			return nil, apperror.Internal("イベントの削除中にサーバーエラーが発生しました。")
 		}
 	}
 
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "error", err)
+
+		return nil, apperror.Internal("イベントの削除中にサーバーエラーが発生しました。")
+	}
+
 	return event, nil
 }
 
@@ -117,14 +265,9 @@ func (r *queryResolver) Events(ctx context.Context) ([]*model.Event, error) {
   `
 	rows, err := r.DB.Query(query)
 	if err != nil {
-		log.Printf("failed to query events: %v", err)
+		slog.ErrorContext(ctx, "failed to query events", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "イベントの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("イベントの取得中にサーバーエラーが発生しました。")
 	}
 	defer rows.Close()
 
@@ -144,26 +287,16 @@ func (r *queryResolver) Events(ctx context.Context) ([]*model.Event, error) {
 			&event.UpdatedBy,
 		)
 		if err != nil {
-			log.Printf("failed to scan event: %v", err)
-
-			return nil, &gqlerror.Error{
-				Message: "イベントの取得中にサーバーエラーが発生しました。",
-				Extensions: map[string]interface{}{
-					"code": "INTERNAL_SERVER_ERROR",
-				},
-			}
+			slog.ErrorContext(ctx, "failed to scan event", "error", err)
+
+			return nil, apperror.Internal("イベントの取得中にサーバーエラーが発生しました。")
 		}
 		events = append(events, &event)
 	}
 
 	if err := rows.Err(); err != nil {
-		log.Printf("error iterating over events: %v", err)
-		return nil, &gqlerror.Error{
-			Message: "イベントの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		slog.ErrorContext(ctx, "error iterating over events", "error", err)
+		return nil, apperror.Internal("イベントの取得中にサーバーエラーが発生しました。")
 	}
 
 	return events, nil
@@ -192,16 +325,11 @@ func (r *queryResolver) EventByID(ctx context.Context, id string) (*model.Event,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("event not found")
+			return nil, apperror.NotFound("イベントが見つかりません。")
 		}
-		log.Printf("failed to query event by ID: %v", err)
+		slog.ErrorContext(ctx, "failed to query event by ID", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "イベントの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("イベントの取得中にサーバーエラーが発生しました。")
 	}
 
 	return &event, nil
@@ -230,16 +358,11 @@ func (r *queryResolver) EventByName(ctx context.Context, name string) (*model.Ev
 		&event.UpdatedBy,
 	); err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("event not found")
+			return nil, apperror.NotFound("イベントが見つかりません。")
 		}
-		log.Printf("failed to query event by name: %v", err)
+		slog.ErrorContext(ctx, "failed to query event by name", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "イベントの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("イベントの取得中にサーバーエラーが発生しました。")
 	}
 
 	return &event, nil
@@ -251,9 +374,5 @@ func (r *Resolver) Event() graph.EventResolver { return &eventResolver{r} }
 // Mutation returns graph.MutationResolver implementation.
 func (r *Resolver) Mutation() graph.MutationResolver { return &mutationResolver{r} }
 
-// Query returns graph.QueryResolver implementation.
-func (r *Resolver) Query() graph.QueryResolver { return &queryResolver{r} }
-
 type eventResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
-type queryResolver struct{ *Resolver }
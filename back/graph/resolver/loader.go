@@ -0,0 +1,25 @@
+package resolver
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph/loader"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+)
+
+// contextのDataLoaderを返す
+// ミドルウェアを通らない呼び出しではその場で作成する
+func (r *Resolver) loaders(ctx context.Context) *loader.Loaders {
+	if l, ok := loader.FromContext(ctx); ok {
+		return l
+	}
+	return loader.New(r.DB)
+}
+
+// DataLoaderの取得失敗をログに残し、クライアントには内部エラーを返す
+func loadFailed(ctx context.Context, message string, err error, args ...any) *apperror.Error {
+	slog.ErrorContext(ctx, "failed to load relation", append(args, "error", err)...)
+
+	return apperror.Internal(message).Wrap(err)
+}
@@ -0,0 +1,165 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+
+	"github.com/noonyuu/nfc/back/graph/loader"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+)
+
+// グローバルIDのオブジェクトを取得する
+// 存在しない場合はエラーにせず、nilを返す
+func (r *Resolver) nodeByID(ctx context.Context, id string) (model.Node, error) {
+	typeName, key, ok := parseNodeID(id)
+	if !ok {
+		return nil, apperror.Invalid("id", invalidNodeIDMessage)
+	}
+	return r.fetchNodeOrFail(ctx, typeName, key)
+}
+
+// 複数のグローバルIDを並行して取得する
+// DataLoaderを使う型は同じ型ごとに1回のクエリにまとまる
+func (r *Resolver) nodesByID(ctx context.Context, ids []string) ([]model.Node, error) {
+	var invalid []apperror.FieldError
+	for i, id := range ids {
+		if _, _, ok := parseNodeID(id); !ok {
+			invalid = append(invalid, apperror.Field(fmt.Sprintf("ids.%d", i), invalidNodeIDMessage))
+		}
+	}
+	if len(invalid) > 0 {
+		return nil, apperror.Validation(invalid...)
+	}
+
+	nodes := make([]model.Node, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			typeName, key, _ := parseNodeID(id)
+			nodes[i], errs[i] = r.fetchNodeOrFail(ctx, typeName, key)
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+const invalidNodeIDMessage = "IDの形式が正しくありません。"
+
+// グローバルIDを型名とキーに分ける
+// 対応していない型や、キーの形式が型に合わない場合はfalseを返す
+func parseNodeID(id string) (typeName, key string, ok bool) {
+	typeName, key, err := model.DecodeGlobalID(id)
+	if err != nil {
+		return "", "", false
+	}
+	switch typeName {
+	case model.NodeTypeWork, model.NodeTypeProfile, model.NodeTypeUser, model.NodeTypeEvent, model.NodeTypeSkill, model.NodeTypeWorkProfile:
+		return typeName, key, true
+	case model.NodeTypeWorkSkill, model.NodeTypeWorkEvent, model.NodeTypeProfileSkill:
+		_, err := strconv.Atoi(key)
+		return typeName, key, err == nil
+	}
+	return "", "", false
+}
+
+func (r *Resolver) fetchNodeOrFail(ctx context.Context, typeName, key string) (model.Node, error) {
+	node, err := r.fetchNode(ctx, typeName, key)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch node", "type", typeName, "key", key, "error", err)
+
+		return nil, apperror.Internal("データの取得中にサーバーエラーが発生しました。").Wrap(err)
+	}
+	return node, nil
+}
+
+func (r *Resolver) fetchNode(ctx context.Context, typeName, key string) (model.Node, error) {
+	switch typeName {
+	case model.NodeTypeWork:
+		return loadNode(r.loaders(ctx).Work.Load(ctx, key))
+	case model.NodeTypeProfile:
+		return loadNode(r.loaders(ctx).Profile.Load(ctx, key))
+	case model.NodeTypeEvent:
+		return loadNode(r.loaders(ctx).Event.Load(ctx, key))
+	case model.NodeTypeUser:
+		var u model.User
+		return scanNode(ctx, r, &u, `
+			SELECT id, first_name, last_name, email, created_at, updated_at
+			FROM users
+			WHERE id = ?
+		`, key, &u.ID, &u.FirstName, &u.LastName, &u.Email, &u.CreatedAt, &u.UpdatedAt)
+	case model.NodeTypeSkill:
+		var s model.Skill
+		return scanNode(ctx, r, &s, `
+			SELECT id, name, category, created_at, updated_at
+			FROM skills
+			WHERE id = ?
+		`, key, &s.ID, &s.Name, &s.Category, &s.CreatedAt, &s.UpdatedAt)
+	case model.NodeTypeWorkProfile:
+		var wp model.WorkProfile
+		return scanNode(ctx, r, &wp, `
+			SELECT id, work_id, profile_id, created_at, updated_at
+			FROM work_profiles
+			WHERE id = ?
+		`, key, &wp.ID, &wp.WorkID, &wp.ProfileID, &wp.CreatedAt, &wp.UpdatedAt)
+	case model.NodeTypeWorkSkill:
+		var ws model.WorkSkill
+		return scanNode(ctx, r, &ws, `
+			SELECT id, work_id, skill_id, created_at, updated_at
+			FROM work_skills
+			WHERE id = ?
+		`, key, &ws.ID, &ws.WorkID, &ws.SkillID, &ws.CreatedAt, &ws.UpdatedAt)
+	case model.NodeTypeWorkEvent:
+		var we model.WorkEvent
+		return scanNode(ctx, r, &we, `
+			SELECT id, work_id, event_id, created_at, updated_at
+			FROM work_events
+			WHERE id = ?
+		`, key, &we.ID, &we.WorkID, &we.EventID, &we.CreatedAt, &we.UpdatedAt)
+	case model.NodeTypeProfileSkill:
+		var ps model.ProfileSkill
+		return scanNode(ctx, r, &ps, `
+			SELECT id, profile_id, skill_id, created_at, updated_at
+			FROM profile_skills
+			WHERE id = ?
+		`, key, &ps.ID, &ps.ProfileID, &ps.SkillID, &ps.CreatedAt, &ps.UpdatedAt)
+	}
+	return nil, fmt.Errorf("unknown node type: %s", typeName)
+}
+
+func loadNode[T model.Node](v T, err error) (model.Node, error) {
+	if errors.Is(err, loader.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// 1件を取得してdestに読み込み、nodeを返す
+func scanNode[T model.Node](ctx context.Context, r *Resolver, node T, query, key string, dest ...any) (model.Node, error) {
+	err := r.DB.QueryRowContext(ctx, query, key).Scan(dest...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
@@ -0,0 +1,24 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+)
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (model.Node, error) {
+	return r.nodeByID(ctx, id)
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]model.Node, error) {
+	if err := r.checkPageSize("ids", len(ids)); err != nil {
+		return nil, err
+	}
+	return r.nodesByID(ctx, ids)
+}
@@ -0,0 +1,40 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+)
+
+func TestParseNodeID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		wantType string
+		wantKey  string
+		wantOK   bool
+	}{
+		{"work", model.EncodeGlobalID(model.NodeTypeWork, "w1"), model.NodeTypeWork, "w1", true},
+		{"work profile", model.EncodeGlobalID(model.NodeTypeWorkProfile, "wp1"), model.NodeTypeWorkProfile, "wp1", true},
+		{"work skill", model.EncodeGlobalID(model.NodeTypeWorkSkill, "12"), model.NodeTypeWorkSkill, "12", true},
+		{"work event", model.EncodeGlobalID(model.NodeTypeWorkEvent, "3"), model.NodeTypeWorkEvent, "3", true},
+		{"profile skill", model.EncodeGlobalID(model.NodeTypeProfileSkill, "4"), model.NodeTypeProfileSkill, "4", true},
+		// 整数のキーを持つ型に整数以外を渡した場合
+		{"work skill with non-integer key", model.EncodeGlobalID(model.NodeTypeWorkSkill, "abc"), "", "", false},
+		{"profile skill with non-integer key", model.EncodeGlobalID(model.NodeTypeProfileSkill, "1.5"), "", "", false},
+		{"unknown type", model.EncodeGlobalID("Session", "s1"), "", "", false},
+		{"raw uuid", "0b8f7a1e-3c2d-4e5f-8a9b-1c2d3e4f5a6b", "", "", false},
+		{"empty", "", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typeName, key, ok := parseNodeID(tt.id)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (typeName != tt.wantType || key != tt.wantKey) {
+				t.Errorf("parsed (%q, %q), want (%q, %q)", typeName, key, tt.wantType, tt.wantKey)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package resolver
+
+import "github.com/noonyuu/nfc/back/internal/apperror"
+
+// 取得件数が1件以上、上限以下であることを確認する（pathには件数の引数名を指定する）
+func (r *Resolver) checkPageSize(path string, n int) error {
+	if n >= 1 && (r.MaxPageSize <= 0 || n <= r.MaxPageSize) {
+		return nil
+	}
+	if r.MaxPageSize > 0 {
+		return apperror.Invalid(path, "取得件数は1〜%d件で指定してください。", r.MaxPageSize)
+	}
+	return apperror.Invalid(path, "取得件数は1件以上を指定してください。")
+}
@@ -0,0 +1,111 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+// CreatePersonalAccessToken is the resolver for the createPersonalAccessToken field.
+func (r *mutationResolver) CreatePersonalAccessToken(ctx context.Context, input model.NewPersonalAccessToken) (*model.CreatedPersonalAccessToken, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
+
+	var expiresInDays *int
+	if input.ExpiresInDays != nil {
+		days := int(*input.ExpiresInDays)
+		expiresInDays = &days
+	}
+
+	token, plaintext, err := r.Tokens.Create(ctx, viewer.UserID, &usecase.CreatePersonalAccessTokenDTO{
+		Name:          input.Name,
+		Scopes:        input.Scopes,
+		ExpiresInDays: expiresInDays,
+	})
+	if errors.Is(err, usecase.ErrInvalidTokenInput) {
+		return nil, apperror.Invalid("input", "トークンの名前・スコープ・有効期限が不正です。").With("scopes", auth.Scopes)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create personal access token", "error", err)
+
+		return nil, apperror.Internal("トークンの作成中にサーバーエラーが発生しました。")
+	}
+
+	return &model.CreatedPersonalAccessToken{
+		Token:               plaintext,
+		PersonalAccessToken: token,
+	}, nil
+}
+
+// RevokePersonalAccessToken is the resolver for the revokePersonalAccessToken field.
+func (r *mutationResolver) RevokePersonalAccessToken(ctx context.Context, id string) (bool, error) {
+	// 自分のトークンのみ削除可能
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Tokens.Revoke(ctx, userID, id)
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ExpiresAt is the resolver for the expiresAt field.
+func (r *personalAccessTokenResolver) ExpiresAt(ctx context.Context, obj *model.PersonalAccessToken) (*string, error) {
+	if obj.ExpiresAt == nil {
+		return nil, nil
+	}
+	expiresAt := obj.ExpiresAt.Format("2006-01-02 15:04:05")
+	return &expiresAt, nil
+}
+
+// LastUsedAt is the resolver for the lastUsedAt field.
+func (r *personalAccessTokenResolver) LastUsedAt(ctx context.Context, obj *model.PersonalAccessToken) (*string, error) {
+	if obj.LastUsedAt == nil {
+		return nil, nil
+	}
+	lastUsedAt := obj.LastUsedAt.Format("2006-01-02 15:04:05")
+	return &lastUsedAt, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *personalAccessTokenResolver) CreatedAt(ctx context.Context, obj *model.PersonalAccessToken) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// MyPersonalAccessTokens is the resolver for the myPersonalAccessTokens field.
+func (r *queryResolver) MyPersonalAccessTokens(ctx context.Context) ([]*model.PersonalAccessToken, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
+
+	tokens, err := r.Tokens.List(ctx, viewer.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list personal access tokens", "error", err)
+
+		return nil, apperror.Internal("トークン一覧の取得中にサーバーエラーが発生しました。")
+	}
+	if tokens == nil {
+		tokens = []*model.PersonalAccessToken{}
+	}
+	return tokens, nil
+}
+
+// PersonalAccessToken returns graph.PersonalAccessTokenResolver implementation.
+func (r *Resolver) PersonalAccessToken() graph.PersonalAccessTokenResolver {
+	return &personalAccessTokenResolver{r}
+}
+
+type personalAccessTokenResolver struct{ *Resolver }
@@ -0,0 +1,25 @@
+package resolver
+
+import (
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+// ドメインの公開設定をGraphQLの公開設定に変換
+func privacySettingsToGraph(s *domainModel.PrivacySettings) *model.PrivacySettings {
+	return &model.PrivacySettings{
+		Email:          directive.VisibilityToGraph(s.Email),
+		Name:           directive.VisibilityToGraph(s.Name),
+		AvatarURL:      directive.VisibilityToGraph(s.AvatarURL),
+		GraduationYear: directive.VisibilityToGraph(s.GraduationYear),
+		Affiliation:    directive.VisibilityToGraph(s.Affiliation),
+		Bio:            directive.VisibilityToGraph(s.Bio),
+		Card: &model.CardPrivacySettings{
+			AvatarURL:      s.CardAvatarURL,
+			GraduationYear: s.CardGraduationYear,
+			Affiliation:    s.CardAffiliation,
+			Bio:            s.CardBio,
+		},
+	}
+}
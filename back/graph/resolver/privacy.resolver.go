@@ -0,0 +1,94 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+// UpdatePrivacySettings is the resolver for the updatePrivacySettings field.
+func (r *mutationResolver) UpdatePrivacySettings(ctx context.Context, input model.UpdatePrivacySettings) (*model.PrivacySettings, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
+
+	// 公開設定はプロフィール単位のため、プロフィールの作成後のみ設定可能
+	if _, err := r.Query().ProfileByUserID(ctx, viewer.UserID); err != nil {
+		return nil, err
+	}
+
+	settings, err := r.Privacy.GetSettings(ctx, viewer.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get privacy settings", "error", err)
+
+		return nil, apperror.Internal("公開設定の取得中にサーバーエラーが発生しました。")
+	}
+
+	// 指定された項目のみ上書きする
+	updated := *settings
+	setVisibility := func(dst *domainModel.Visibility, v *model.Visibility) {
+		if v != nil {
+			*dst = directive.VisibilityFromGraph(*v)
+		}
+	}
+	setVisibility(&updated.Email, input.Email)
+	setVisibility(&updated.Name, input.Name)
+	setVisibility(&updated.AvatarURL, input.AvatarURL)
+	setVisibility(&updated.GraduationYear, input.GraduationYear)
+	setVisibility(&updated.Affiliation, input.Affiliation)
+	setVisibility(&updated.Bio, input.Bio)
+
+	if card := input.Card; card != nil {
+		if card.AvatarURL != nil {
+			updated.CardAvatarURL = *card.AvatarURL
+		}
+		if card.GraduationYear != nil {
+			updated.CardGraduationYear = *card.GraduationYear
+		}
+		if card.Affiliation != nil {
+			updated.CardAffiliation = *card.Affiliation
+		}
+		if card.Bio != nil {
+			updated.CardBio = *card.Bio
+		}
+	}
+
+	if err := r.Privacy.UpdateSettings(ctx, &updated); err != nil {
+		if errors.Is(err, usecase.ErrInvalidVisibility) {
+			return nil, apperror.Invalid("input", "不正な公開範囲です。")
+		}
+		slog.ErrorContext(ctx, "failed to update privacy settings", "error", err)
+
+		return nil, apperror.Internal("公開設定の更新中にサーバーエラーが発生しました。")
+	}
+
+	return privacySettingsToGraph(&updated), nil
+}
+
+// MyPrivacySettings is the resolver for the myPrivacySettings field.
+func (r *queryResolver) MyPrivacySettings(ctx context.Context) (*model.PrivacySettings, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
+
+	settings, err := r.Privacy.GetSettings(ctx, viewer.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get privacy settings", "error", err)
+
+		return nil, apperror.Internal("公開設定の取得中にサーバーエラーが発生しました。")
+	}
+	return privacySettingsToGraph(settings), nil
+}
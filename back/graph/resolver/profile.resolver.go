@@ -8,13 +8,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/noonyuu/nfc/back/graph"
 	"github.com/noonyuu/nfc/back/graph/model"
-	"github.com/vektah/gqlparser/gqlerror"
+	"github.com/noonyuu/nfc/back/internal/apperror"
 )
 
 // CreateProfile is the resolver for the createProfile field.
@@ -96,14 +96,9 @@ func (r *mutationResolver) CreateProfile(ctx context.Context, input model.NewPro
 		profileToInsert.UpdatedAt,
 	)
 	if err != nil {
-		log.Printf("failed to insert profile: %v", err)
+		slog.ErrorContext(ctx, "failed to insert profile", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "プロフィールの作成中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("プロフィールの作成中にサーバーエラーが発生しました。")
 	}
 
 	return profileToInsert, nil
@@ -111,6 +106,12 @@ func (r *mutationResolver) CreateProfile(ctx context.Context, input model.NewPro
 
 // UpdateProfile is the resolver for the updateProfile field.
 func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.UpdateProfile) (*model.Profile, error) {
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Authz.CanEditProfile(ctx, userID, input.ID)
+	}); err != nil {
+		return nil, err
+	}
+
 	// 現在時刻を取得
 	now := time.Now()
 
@@ -159,12 +160,7 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 
 	// 更新するフィールドがない場合
 	if len(setParts) == 0 {
-		return nil, &gqlerror.Error{
-			Message: "更新するフィールドが指定されていません。",
-			Extensions: map[string]interface{}{
-				"code": "BAD_REQUEST",
-			},
-		}
+		return nil, apperror.Invalid("input", "更新するフィールドが指定されていません。")
 	}
 
 	// updated_atは常に更新
@@ -182,26 +178,16 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 
 	result, err := r.DB.ExecContext(ctx, updateQuery, args...)
 	if err != nil {
-		log.Printf("failed to update profile: %v", err)
-		return nil, &gqlerror.Error{
-			Message: "プロフィールの更新中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		slog.ErrorContext(ctx, "failed to update profile", "error", err)
+		return nil, apperror.Internal("プロフィールの更新中にサーバーエラーが発生しました。")
 	}
 
 	// 更新されたレコード数を確認
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		log.Printf("failed to get rows affected: %v", err)
+		slog.ErrorContext(ctx, "failed to get rows affected", "error", err)
 	} else if rowsAffected == 0 {
-		return nil, &gqlerror.Error{
-			Message: "指定されたプロフィールが見つかりません。",
-			Extensions: map[string]interface{}{
-				"code": "NOT_FOUND",
-			},
-		}
+		return nil, apperror.NotFound("指定されたプロフィールが見つかりません。")
 	}
 
 	selectQuery := `
@@ -225,22 +211,12 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 		&fetchedProfile.UpdatedAt,
 	); err != nil {
 		if err == sql.ErrNoRows {
-			log.Printf("profile with id %s not found after update", input.ID)
-			return nil, &gqlerror.Error{
-				Message: "プロフィールが見つかりません。",
-				Extensions: map[string]interface{}{
-					"code": "NOT_FOUND",
-				},
-			}
+			slog.InfoContext(ctx, "profile not found after update", "id", input.ID)
+			return nil, apperror.NotFound("プロフィールが見つかりません。")
 		}
-		log.Printf("failed to scan updated profile: %v", err)
+		slog.ErrorContext(ctx, "failed to scan updated profile", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "プロフィールの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("プロフィールの取得中にサーバーエラーが発生しました。")
 	}
 
 	// sql.NullInt32 から model.Profile の *int32 へ変換
@@ -250,6 +226,8 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 		fetchedProfile.GraduationYear = nil
 	}
 
+	r.PubSub.PublishOrLog(ctx, topicProfileUpdated+fetchedProfile.ID, profileUpdatedMessage{ProfileID: fetchedProfile.ID})
+
 	return &fetchedProfile, nil
 }
 
@@ -290,23 +268,13 @@ func (r *queryResolver) Profile(ctx context.Context, id string) (*model.Profile,
 		&profile.UpdatedAt,
 	); err != nil {
 		if err == sql.ErrNoRows {
-			log.Printf("profile with id %s not found", id)
+			slog.InfoContext(ctx, "profile not found", "id", id)
 
-			return nil, &gqlerror.Error{
-				Message: "プロフィールが見つかりません。",
-				Extensions: map[string]interface{}{
-					"code": "NOT_FOUND",
-				},
-			}
+			return nil, apperror.NotFound("プロフィールが見つかりません。")
 		}
-		log.Printf("failed to scan profile data for ID %s: %v", id, err)
+		slog.ErrorContext(ctx, "failed to scan profile data", "id", id, "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "プロフィールの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("プロフィールの取得中にサーバーエラーが発生しました。")
 	}
 
 	if avatarURL.Valid {
@@ -372,23 +340,13 @@ func (r *queryResolver) ProfileByNickName(ctx context.Context, nickName string)
 		&profile.UpdatedAt,
 	); err != nil {
 		if err == sql.ErrNoRows {
-			log.Printf("profile with nick name %s not found", nickName)
+			slog.InfoContext(ctx, "profile not found", "nick_name", nickName)
 
-			return nil, &gqlerror.Error{
-				Message: "プロフィールが見つかりません。",
-				Extensions: map[string]interface{}{
-					"code": "NOT_FOUND",
-				},
-			}
+			return nil, apperror.NotFound("プロフィールが見つかりません。")
 		}
-		log.Printf("failed to scan profile by user name %s: %v", nickName, err)
+		slog.ErrorContext(ctx, "failed to scan profile by nick name", "nick_name", nickName, "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "プロフィールの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("プロフィールの取得中にサーバーエラーが発生しました。")
 	}
 
 	if avatarURL.Valid {
@@ -451,23 +409,13 @@ func (r *queryResolver) ProfileByUserID(ctx context.Context, id string) (*model.
 		&profile.UpdatedAt,
 	); err != nil {
 		if err == sql.ErrNoRows {
-			log.Printf("profile with id %s not found", id)
+			slog.InfoContext(ctx, "profile not found", "id", id)
 
-			return nil, &gqlerror.Error{
-				Message: "プロフィールが見つかりません。",
-				Extensions: map[string]interface{}{
-					"code": "NOT_FOUND",
-				},
-			}
+			return nil, apperror.NotFound("プロフィールが見つかりません。")
 		}
-		log.Printf("failed to scan profile data for ID %s: %v", id, err)
+		slog.ErrorContext(ctx, "failed to scan profile data", "id", id, "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "プロフィールの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("プロフィールの取得中にサーバーエラーが発生しました。")
 	}
 
 	if avatarURL.Valid {
@@ -6,16 +6,22 @@ package resolver
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/noonyuu/nfc/back/graph"
 	"github.com/noonyuu/nfc/back/graph/model"
-	"github.com/vektah/gqlparser/gqlerror"
+	"github.com/noonyuu/nfc/back/internal/apperror"
 )
 
 // CreateProfileSkill is the resolver for the createProfileSkill field.
 func (r *mutationResolver) CreateProfileSkill(ctx context.Context, input model.NewProfileSkill) (*model.ProfileSkill, error) {
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Authz.CanEditProfile(ctx, userID, input.ProfileID)
+	}); err != nil {
+		return nil, err
+	}
+
 	// 現在時刻を取得
 	now := time.Now()
 	// ProfileSkill構造体にUUIDと現在時刻をセット
@@ -31,14 +37,9 @@ func (r *mutationResolver) CreateProfileSkill(ctx context.Context, input model.N
 	VALUES (?, ?, ?, ?)
 `
 	if _, err := r.DB.Exec(query, profileSkill.ProfileID, profileSkill.SkillID, now, now); err != nil {
-		log.Printf("failed to insert profile skill: %v", err)
+		slog.ErrorContext(ctx, "failed to insert profile skill", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの登録に失敗しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("スキルの登録に失敗しました。")
 	}
 
 	return profileSkill, nil
@@ -46,18 +47,19 @@ func (r *mutationResolver) CreateProfileSkill(ctx context.Context, input model.N
 
 // DeleteProfileSkill is the resolver for the deleteProfileSkill field.
 func (r *mutationResolver) DeleteProfileSkill(ctx context.Context, id int32) (*model.ProfileSkill, error) {
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Authz.CanEditProfileSkill(ctx, userID, id)
+	}); err != nil {
+		return nil, err
+	}
+
 	query := `
 	DELETE FROM profile_skills WHERE id = ?
 `
 	if _, err := r.DB.Exec(query, id); err != nil {
-		log.Printf("failed to delete profile skill: %v", err)
+		slog.ErrorContext(ctx, "failed to delete profile skill", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの削除に失敗しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("スキルの削除に失敗しました。")
 	}
 
 	return &model.ProfileSkill{ID: id}, nil
@@ -82,14 +84,9 @@ func (r *queryResolver) ProfileSkill(ctx context.Context, id int32) (*model.Prof
 `
 	var profileSkill model.ProfileSkill
 	if err := r.DB.QueryRow(query, id).Scan(&profileSkill.ID, &profileSkill.ProfileID, &profileSkill.SkillID, &profileSkill.CreatedAt, &profileSkill.UpdatedAt); err != nil {
-		log.Printf("failed to get profile skill: %v", err)
+		slog.ErrorContext(ctx, "failed to get profile skill", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの取得に失敗しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("スキルの取得に失敗しました。")
 	}
 
 	return &profileSkill, nil
@@ -104,14 +101,9 @@ func (r *queryResolver) ProfileSkillsByProfileID(ctx context.Context, profileID
 `
 	rows, err := r.DB.Query(query, profileID)
 	if err != nil {
-		log.Printf("failed to query profile skills: %v", err)
+		slog.ErrorContext(ctx, "failed to query profile skills", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
 	}
 	defer rows.Close()
 
@@ -119,14 +111,9 @@ func (r *queryResolver) ProfileSkillsByProfileID(ctx context.Context, profileID
 	for rows.Next() {
 		var profileSkill model.ProfileSkill
 		if err := rows.Scan(&profileSkill.ID, &profileSkill.ProfileID, &profileSkill.SkillID, &profileSkill.CreatedAt, &profileSkill.UpdatedAt); err != nil {
-			log.Printf("failed to scan profile skill: %v", err)
-
-			return nil, &gqlerror.Error{
-				Message: "スキルの取得中にサーバーエラーが発生しました。",
-				Extensions: map[string]interface{}{
-					"code": "INTERNAL_SERVER_ERROR",
-				},
-			}
+			slog.ErrorContext(ctx, "failed to scan profile skill", "error", err)
+
+			return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
 		}
 		profileSkills = append(profileSkills, &profileSkill)
 	}
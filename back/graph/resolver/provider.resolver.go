@@ -0,0 +1,58 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+// UnlinkProvider is the resolver for the unlinkProvider field.
+func (r *mutationResolver) UnlinkProvider(ctx context.Context, provider string) (bool, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return false, directive.Unauthenticated()
+	}
+
+	err := r.Auth.UnlinkProvider(ctx, viewer.UserID, provider)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, usecase.ErrLastProvider):
+		return false, apperror.Conflict("最後のログイン方法は解除できません。").With("reason", "LAST_PROVIDER")
+	case errors.Is(err, usecase.ErrResourceNotFound):
+		return false, apperror.NotFound("連携されていないログイン方法です。")
+	default:
+		slog.ErrorContext(ctx, "failed to unlink provider", "error", err)
+
+		return false, apperror.Internal("ログイン方法の解除中にサーバーエラーが発生しました。")
+	}
+}
+
+// MyProviders is the resolver for the myProviders field.
+func (r *queryResolver) MyProviders(ctx context.Context) ([]*model.Provider, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
+
+	providers, err := r.Auth.ListProviders(ctx, viewer.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list providers", "error", err)
+
+		return nil, apperror.Internal("連携済みのログイン方法の取得中にサーバーエラーが発生しました。")
+	}
+	if providers == nil {
+		providers = []*model.Provider{}
+	}
+	return providers, nil
+}
@@ -3,6 +3,8 @@ package resolver
 //go:generate go run github.com/99designs/gqlgen generate
 import (
 	"github.com/jmoiron/sqlx"
+	"github.com/noonyuu/nfc/back/internal/pubsub"
+	"github.com/noonyuu/nfc/back/internal/usecase"
 )
 
 // This file will not be regenerated automatically.
@@ -10,5 +12,16 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	DB *sqlx.DB
+	DB       *sqlx.DB
+	Audit    usecase.AuditLogUsecase
+	Auth     usecase.AuthUsecase
+	Authz    usecase.AuthorizationUsecase
+	Privacy  usecase.PrivacyUsecase
+	Roles    usecase.RoleUsecase
+	Sessions usecase.SessionUsecase
+	Tokens   usecase.PersonalAccessTokenUsecase
+	PubSub   *pubsub.PubSub
+
+	// 一度に取得できる件数の上限
+	MaxPageSize int
 }
@@ -0,0 +1,35 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+// SetUserRole is the resolver for the setUserRole field.
+func (r *mutationResolver) SetUserRole(ctx context.Context, userID string, role model.Role) (*model.User, error) {
+	err := r.Roles.SetRole(ctx, userID, directive.RoleFromGraph(role))
+	switch {
+	case err == nil:
+		return r.Query().UserByID(ctx, userID)
+	case errors.Is(err, usecase.ErrResourceNotFound):
+		return nil, apperror.NotFound("ユーザーが見つかりません。")
+	case errors.Is(err, usecase.ErrInvalidRole):
+		return nil, apperror.Invalid("role", "不正な権限です。")
+	case errors.Is(err, usecase.ErrLastAdmin):
+		return nil, apperror.Conflict("最後の管理者の権限は変更できません。").With("reason", "LAST_ADMIN")
+	default:
+		slog.ErrorContext(ctx, "failed to set user role", "error", err)
+
+		return nil, apperror.Internal("権限の変更中にサーバーエラーが発生しました。")
+	}
+}
@@ -0,0 +1,87 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+)
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	// 自分の端末セッションのみ失効可能
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Sessions.RevokeDeviceSession(ctx, userID, id)
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RevokeOtherSessions is the resolver for the revokeOtherSessions field.
+func (r *mutationResolver) RevokeOtherSessions(ctx context.Context) (int32, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return 0, directive.Unauthenticated()
+	}
+
+	revoked, err := r.Sessions.RevokeOtherDeviceSessions(ctx, viewer.UserID, viewer.SessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to revoke sessions", "error", err)
+
+		return 0, apperror.Internal("セッションの失効中にサーバーエラーが発生しました。")
+	}
+	return int32(revoked), nil
+}
+
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*model.Session, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
+
+	deviceSessions, err := r.Sessions.ListDeviceSessions(ctx, viewer.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to list sessions", "error", err)
+
+		return nil, apperror.Internal("セッション一覧の取得中にサーバーエラーが発生しました。")
+	}
+
+	sessions := make([]*model.Session, 0, len(deviceSessions))
+	for _, s := range deviceSessions {
+		sessions = append(sessions, &model.Session{
+			ID:         s.ID,
+			DeviceName: s.DeviceName,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			Current:    s.ID == viewer.SessionID,
+		})
+	}
+	return sessions, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *sessionResolver) CreatedAt(ctx context.Context, obj *model.Session) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// LastUsedAt is the resolver for the lastUsedAt field.
+func (r *sessionResolver) LastUsedAt(ctx context.Context, obj *model.Session) (string, error) {
+	return obj.LastUsedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// Session returns graph.SessionResolver implementation.
+func (r *Resolver) Session() graph.SessionResolver { return &sessionResolver{r} }
+
+type sessionResolver struct{ *Resolver }
@@ -0,0 +1,35 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+)
+
+// IDからスキルを取得
+func (r *Resolver) skillByID(ctx context.Context, id string) (*model.Skill, error) {
+	query := `
+		SELECT id, name, category, created_at, updated_at
+		FROM skills
+		WHERE id = ?
+	`
+	var skill model.Skill
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&skill.ID, &skill.Name, &skill.Category, &skill.CreatedAt, &skill.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, skillNotFound()
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to query skill by ID", "error", err)
+
+		return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
+	}
+	return &skill, nil
+}
+
+func skillNotFound() *apperror.Error {
+	return apperror.NotFound("スキルが見つかりません。")
+}
@@ -6,13 +6,17 @@ package resolver
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/noonyuu/nfc/back/graph"
 	"github.com/noonyuu/nfc/back/graph/model"
-	"github.com/vektah/gqlparser/gqlerror"
+	"github.com/noonyuu/nfc/back/internal/apperror"
 )
 
 // CreateSkill is the resolver for the createSkill field.
@@ -38,16 +42,85 @@ func (r *mutationResolver) CreateSkill(ctx context.Context, input model.NewSkill
 	`
 	_, err := r.DB.Exec(query, skill.ID, skill.Name, skill.Category, now, now)
 	if err != nil {
-		log.Printf("failed to insert skill: %v", err)
+		slog.ErrorContext(ctx, "failed to insert skill", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの登録に失敗しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
+		return nil, apperror.Internal("スキルの登録に失敗しました。")
+	}
+
+	return skill, nil
+}
+
+// UpdateSkill is the resolver for the updateSkill field.
+func (r *mutationResolver) UpdateSkill(ctx context.Context, id string, input model.UpdateSkill) (*model.Skill, error) {
+	// 動的にUPDATE文を構築
+	var setParts []string
+	var args []interface{}
+
+	if input.Name != nil {
+		setParts = append(setParts, "name = ?")
+		args = append(args, *input.Name)
+	}
+	if input.Category != nil {
+		setParts = append(setParts, "category = ?")
+		args = append(args, *input.Category)
+	}
+
+	// 更新するフィールドがない場合
+	if len(setParts) == 0 {
+		return nil, apperror.Invalid("input", "更新するフィールドが指定されていません。")
+	}
+
+	setParts = append(setParts, "updated_at = ?")
+	args = append(args, time.Now(), id)
+
+	updateQuery := fmt.Sprintf("UPDATE skills SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := r.DB.ExecContext(ctx, updateQuery, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update skill", "error", err)
+
+		return nil, apperror.Internal("スキルの更新中にサーバーエラーが発生しました。")
+	}
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return nil, skillNotFound()
+	}
+
+	return r.skillByID(ctx, id)
+}
+
+// DeleteSkill is the resolver for the deleteSkill field.
+func (r *mutationResolver) DeleteSkill(ctx context.Context, id string) (*model.Skill, error) {
+	skill, err := r.skillByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// スキルを参照している作品・プロフィールの紐づけも削除する
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to begin transaction", "error", err)
+
+		return nil, apperror.Internal("スキルの削除中にサーバーエラーが発生しました。")
+	}
+	defer tx.Rollback()
+
+	for _, query := range []string{
+		"DELETE FROM work_skills WHERE skill_id = ?",
+		"DELETE FROM profile_skills WHERE skill_id = ?",
+		"DELETE FROM skills WHERE id = ?",
+	} {
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			slog.ErrorContext(ctx, "failed to delete skill", "error", err)
+
+			return nil, apperror.Internal("スキルの削除中にサーバーエラーが発生しました。")
 		}
 	}
 
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "failed to commit transaction", "error", err)
+
+		return nil, apperror.Internal("スキルの削除中にサーバーエラーが発生しました。")
+	}
+
 	return skill, nil
 }
 
@@ -61,14 +134,14 @@ func (r *queryResolver) SkillByName(ctx context.Context, name string) (*model.Sk
 	row := r.DB.QueryRow(query, name)
 	var skill model.Skill
 	if err := row.Scan(&skill.ID, &skill.Name, &skill.Category, &skill.CreatedAt, &skill.UpdatedAt); err != nil {
-		log.Printf("failed to query skill by name: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			slog.InfoContext(ctx, "skill not found", "name", name)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの取得に失敗しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
+			return nil, apperror.NotFound("スキルが見つかりません。")
 		}
+		slog.ErrorContext(ctx, "failed to query skill by name", "error", err)
+
+		return nil, apperror.Internal("スキルの取得に失敗しました。")
 	}
 	// スキルを返す
 	return &skill, nil
@@ -82,14 +155,9 @@ func (r *queryResolver) Skills(ctx context.Context) ([]*model.Skill, error) {
 	`
 	rows, err := r.DB.Query(query)
 	if err != nil {
-		log.Printf("failed to query skills: %v", err)
+		slog.ErrorContext(ctx, "failed to query skills", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
 	}
 	defer rows.Close()
 
@@ -97,27 +165,17 @@ func (r *queryResolver) Skills(ctx context.Context) ([]*model.Skill, error) {
 	for rows.Next() {
 		var skill model.Skill
 		if err := rows.Scan(&skill.ID, &skill.Name, &skill.Category, &skill.CreatedAt, &skill.UpdatedAt); err != nil {
-			log.Printf("failed to scan skill: %v", err)
-
-			return nil, &gqlerror.Error{
-				Message: "スキルの取得中にサーバーエラーが発生しました。",
-				Extensions: map[string]interface{}{
-					"code": "INTERNAL_SERVER_ERROR",
-				},
-			}
+			slog.ErrorContext(ctx, "failed to scan skill", "error", err)
+
+			return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
 		}
 		skills = append(skills, &skill)
 	}
 
 	if err := rows.Err(); err != nil {
-		log.Printf("error iterating over skills: %v", err)
+		slog.ErrorContext(ctx, "error iterating over skills", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "スキルの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
 	}
 
 	return skills, nil
@@ -0,0 +1,125 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/noonyuu/nfc/back/graph/loader"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+)
+
+// サブスクリプションに配信するイベントのトピック（末尾に対象のIDを付ける）
+const (
+	topicWorkAddedToEvent = "work_added_to_event:"
+	topicCardScanned      = "card_scanned:"
+	topicProfileUpdated   = "profile_updated:"
+)
+
+// インスタンス間ではIDだけを送り、受信側で最新の内容を取得する
+type workAddedToEventMessage struct {
+	WorkID string `json:"workId"`
+}
+
+type cardScannedMessage struct {
+	WorkProfileID string    `json:"workProfileId"`
+	ScannedAt     time.Time `json:"scannedAt"`
+}
+
+type profileUpdatedMessage struct {
+	ProfileID string `json:"profileId"`
+}
+
+// 配信が無い間も認証が有効かを確認する間隔
+var subscriptionAuthCheckInterval = time.Minute
+
+// topicを購読し、受け取ったメッセージごとにloadで取得した値を配信する
+// 取得に失敗したメッセージは読み飛ばす
+func stream[M, T any](ctx context.Context, r *Resolver, topic string, load func(ctx context.Context, msg M) (T, error)) (<-chan T, error) {
+	if r.PubSub == nil {
+		return nil, subscriptionUnavailable()
+	}
+	messages, err := r.PubSub.Subscribe(ctx, topic)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to subscribe", "topic", topic, "error", err)
+		return nil, subscriptionUnavailable()
+	}
+
+	out := make(chan T, 1)
+	go func() {
+		defer close(out)
+
+		// ログアウト・セッションの失効・トークンの期限切れ後は配信せずに終了する
+		// 配信が無くても終了できるよう、定期的に確認し、トークンの有効期限には必ず終了する
+		viewer := auth.ViewerFromContext(ctx)
+		authenticated := func() bool {
+			if viewer == nil || auth.StillAuthenticated(ctx, viewer, r.Sessions, r.Tokens) {
+				return true
+			}
+			slog.InfoContext(ctx, "subscription closed because the viewer is no longer authenticated", "topic", topic)
+			return false
+		}
+		ticker := time.NewTicker(subscriptionAuthCheckInterval)
+		defer ticker.Stop()
+		var expired <-chan time.Time
+		if viewer != nil && !viewer.ExpiresAt.IsZero() {
+			timer := time.NewTimer(time.Until(viewer.ExpiresAt))
+			defer timer.Stop()
+			expired = timer.C
+		}
+
+		for {
+			var b []byte
+			select {
+			case <-ctx.Done():
+				return
+			case <-expired:
+				slog.InfoContext(ctx, "subscription closed because the token expired", "topic", topic)
+				return
+			case <-ticker.C:
+				if !authenticated() {
+					return
+				}
+				continue
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				b = msg
+			}
+			if !authenticated() {
+				return
+			}
+			var msg M
+			if err := json.Unmarshal(b, &msg); err != nil {
+				slog.WarnContext(ctx, "invalid subscription message", "topic", topic, "error", err)
+				continue
+			}
+			v, err := load(MessageContext(ctx, r.DB), msg)
+			if err != nil {
+				slog.WarnContext(ctx, "failed to load subscription payload", "topic", topic, "error", err)
+				continue
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// 配信ごとのcontext
+// 接続中はcontextが使い回されるため、DataLoaderと公開設定のキャッシュを作り直して古い内容を返さないようにする
+func MessageContext(ctx context.Context, db *sqlx.DB) context.Context {
+	return usecase.WithPrivacyCache(loader.WithLoaders(ctx, loader.New(db)))
+}
+
+func subscriptionUnavailable() *apperror.Error {
+	return apperror.Internal("リアルタイム通知を開始できませんでした。")
+}
@@ -0,0 +1,52 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.72
+
+import (
+	"context"
+
+	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/auth"
+)
+
+// WorkAddedToEvent is the resolver for the workAddedToEvent field.
+func (r *subscriptionResolver) WorkAddedToEvent(ctx context.Context, eventID string) (<-chan *model.Work, error) {
+	return stream(ctx, r.Resolver, topicWorkAddedToEvent+eventID, func(ctx context.Context, msg workAddedToEventMessage) (*model.Work, error) {
+		return r.Query().Work(ctx, msg.WorkID)
+	})
+}
+
+// MyCardScanned is the resolver for the myCardScanned field.
+func (r *subscriptionResolver) MyCardScanned(ctx context.Context) (<-chan *model.CardScan, error) {
+	viewer := auth.ViewerFromContext(ctx)
+	if viewer == nil {
+		return nil, directive.Unauthenticated()
+	}
+	// プロフィールのIDはユーザーIDと同じ
+	return stream(ctx, r.Resolver, topicCardScanned+viewer.UserID, func(ctx context.Context, msg cardScannedMessage) (*model.CardScan, error) {
+		wp, err := r.workProfileByID(ctx, msg.WorkProfileID)
+		if err != nil {
+			return nil, err
+		}
+		return &model.CardScan{
+			WorkProfile: wp,
+			ScannedAt:   msg.ScannedAt.Format("2006-01-02 15:04:05"),
+		}, nil
+	})
+}
+
+// ProfileUpdated is the resolver for the profileUpdated field.
+func (r *subscriptionResolver) ProfileUpdated(ctx context.Context, id string) (<-chan *model.Profile, error) {
+	return stream(ctx, r.Resolver, topicProfileUpdated+id, func(ctx context.Context, msg profileUpdatedMessage) (*model.Profile, error) {
+		return r.Query().Profile(ctx, msg.ProfileID)
+	})
+}
+
+// Subscription returns graph.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() graph.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,159 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/pubsub"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+	"github.com/redis/go-redis/v9"
+)
+
+// 端末セッションの有効・失効を切り替えられるSessionUsecase
+type fakeSessions struct {
+	usecase.SessionUsecase
+	revoked atomic.Bool
+}
+
+func (f *fakeSessions) IsDeviceSessionActive(ctx context.Context, userID, sessionID string) (bool, error) {
+	return !f.revoked.Load(), nil
+}
+
+func newStreamResolver(t *testing.T) (*Resolver, *fakeSessions) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	ps := pubsub.New(rdb)
+	t.Cleanup(func() { ps.Close() })
+	sessions := &fakeSessions{}
+	return &Resolver{PubSub: ps, Sessions: sessions}, sessions
+}
+
+func setAuthCheckInterval(t *testing.T, d time.Duration) {
+	t.Helper()
+	prev := subscriptionAuthCheckInterval
+	subscriptionAuthCheckInterval = d
+	t.Cleanup(func() { subscriptionAuthCheckInterval = prev })
+}
+
+// チャンネルが閉じられるまで待つ（閉じる前に届いた値は返す）
+func waitClosed[T any](t *testing.T, ch <-chan T) []T {
+	t.Helper()
+	var got []T
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				return got
+			}
+			got = append(got, v)
+		case <-timeout:
+			t.Fatal("stream was not closed")
+		}
+	}
+}
+
+func TestStreamDeliversLoadedMessages(t *testing.T) {
+	r, _ := newStreamResolver(t)
+	ctx, cancel := context.WithCancel(auth.WithViewer(context.Background(), &auth.Viewer{UserID: "u1", SessionID: "s1"}))
+	defer cancel()
+
+	out, err := stream(ctx, r, topicProfileUpdated+"p1", func(ctx context.Context, msg profileUpdatedMessage) (string, error) {
+		if msg.ProfileID == "missing" {
+			return "", errors.New("not found")
+		}
+		return "loaded " + msg.ProfileID, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 不正なメッセージと取得に失敗したメッセージは読み飛ばす
+	r.PubSub.PublishOrLog(ctx, topicProfileUpdated+"p1", "not an object")
+	r.PubSub.PublishOrLog(ctx, topicProfileUpdated+"p1", profileUpdatedMessage{ProfileID: "missing"})
+	r.PubSub.PublishOrLog(ctx, topicProfileUpdated+"p2", profileUpdatedMessage{ProfileID: "p2"})
+	r.PubSub.PublishOrLog(ctx, topicProfileUpdated+"p1", profileUpdatedMessage{ProfileID: "p1"})
+
+	select {
+	case got := <-out:
+		if got != "loaded p1" {
+			t.Errorf("payload = %q, want %q", got, "loaded p1")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no payload delivered")
+	}
+
+	cancel()
+	waitClosed(t, out)
+}
+
+// 配信が無くても、セッションが失効したら終了する
+func TestStreamClosesWhenSessionRevoked(t *testing.T) {
+	setAuthCheckInterval(t, 10*time.Millisecond)
+	r, sessions := newStreamResolver(t)
+	ctx := auth.WithViewer(context.Background(), &auth.Viewer{UserID: "u1", SessionID: "s1"})
+
+	out, err := stream(ctx, r, topicCardScanned+"wp1", func(ctx context.Context, msg cardScannedMessage) (string, error) {
+		return msg.WorkProfileID, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessions.revoked.Store(true)
+	waitClosed(t, out)
+}
+
+// 確認の間隔に関わらず、トークンの有効期限で終了する
+func TestStreamClosesAtExpiry(t *testing.T) {
+	setAuthCheckInterval(t, time.Hour)
+	r, _ := newStreamResolver(t)
+	viewer := &auth.Viewer{UserID: "u1", SessionID: "s1", ExpiresAt: time.Now().Add(50 * time.Millisecond)}
+	ctx := auth.WithViewer(context.Background(), viewer)
+
+	out, err := stream(ctx, r, topicWorkAddedToEvent+"e1", func(ctx context.Context, msg workAddedToEventMessage) (string, error) {
+		return msg.WorkID, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	waitClosed(t, out)
+	if time.Since(start) > time.Second {
+		t.Errorf("stream closed %s after expiry", time.Since(start))
+	}
+}
+
+// 失効後に届いたメッセージは配信しない
+func TestStreamDropsMessagesAfterRevocation(t *testing.T) {
+	setAuthCheckInterval(t, time.Hour)
+	r, sessions := newStreamResolver(t)
+	ctx := auth.WithViewer(context.Background(), &auth.Viewer{UserID: "u1", SessionID: "s1"})
+
+	out, err := stream(ctx, r, topicProfileUpdated+"p1", func(ctx context.Context, msg profileUpdatedMessage) (string, error) {
+		return msg.ProfileID, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sessions.revoked.Store(true)
+	r.PubSub.PublishOrLog(ctx, topicProfileUpdated+"p1", profileUpdatedMessage{ProfileID: "p1"})
+	if got := waitClosed(t, out); len(got) != 0 {
+		t.Errorf("delivered %v after revocation", got)
+	}
+}
+
+func TestStreamWithoutPubSub(t *testing.T) {
+	_, err := stream(context.Background(), &Resolver{}, topicProfileUpdated+"p1", func(ctx context.Context, msg profileUpdatedMessage) (string, error) {
+		return msg.ProfileID, nil
+	})
+	if err == nil {
+		t.Fatal("stream() = nil, want an error")
+	}
+}
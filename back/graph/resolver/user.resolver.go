@@ -6,13 +6,14 @@ package resolver
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/directive"
 	"github.com/noonyuu/nfc/back/graph/model"
-	"github.com/vektah/gqlparser/gqlerror"
+	"github.com/noonyuu/nfc/back/internal/apperror"
 )
 
 // CreateUser is the resolver for the createUser field.
@@ -40,14 +41,9 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.NewUser)
 
 	_, err := r.DB.Exec(query, user.ID, input.FirstName, input.LastName, input.Email, user.CreatedAt, user.UpdatedAt)
 	if err != nil {
-		log.Printf("failed to insert user: %v", err)
+		slog.ErrorContext(ctx, "failed to insert user", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "ユーザーの登録に失敗しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("ユーザーの登録に失敗しました。")
 	}
 
 	return user, nil
@@ -63,14 +59,9 @@ func (r *queryResolver) UserByID(ctx context.Context, id string) (*model.User, e
 	row := r.DB.QueryRow(query, id)
 	var user model.User
 	if err := row.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.CreatedAt, &user.UpdatedAt); err != nil {
-		log.Printf("failed to query user by ID: %v", err)
+		slog.ErrorContext(ctx, "failed to query user by ID", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "ユーザーの取得に失敗しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("ユーザーの取得に失敗しました。")
 	}
 
 	return &user, nil
@@ -84,14 +75,9 @@ func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
 	`
 	rows, err := r.DB.Query(query)
 	if err != nil {
-		log.Printf("failed to query users: %v", err)
+		slog.ErrorContext(ctx, "failed to query users", "error", err)
 
-		return nil, &gqlerror.Error{
-			Message: "ユーザーの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("ユーザーの取得中にサーバーエラーが発生しました。")
 	}
 	defer rows.Close()
 
@@ -100,31 +86,32 @@ func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
 	for rows.Next() {
 		var user model.User
 		if err = rows.Scan(&user.ID, &user.FirstName, &user.LastName, &user.Email, &user.CreatedAt, &user.UpdatedAt); err != nil {
-			log.Printf("failed to scan user: %v", err)
-
-			return nil, &gqlerror.Error{
-				Message: "ユーザーの取得中にサーバーエラーが発生しました。",
-				Extensions: map[string]interface{}{
-					"code": "INTERNAL_SERVER_ERROR",
-				},
-			}
+			slog.ErrorContext(ctx, "failed to scan user", "error", err)
+
+			return nil, apperror.Internal("ユーザーの取得中にサーバーエラーが発生しました。")
 		}
 
 		users = append(users, &user)
 	}
 
 	if er := rows.Err(); er != nil {
-		return nil, &gqlerror.Error{
-			Message: "ユーザーの取得中にサーバーエラーが発生しました。",
-			Extensions: map[string]interface{}{
-				"code": "INTERNAL_SERVER_ERROR",
-			},
-		}
+		return nil, apperror.Internal("ユーザーの取得中にサーバーエラーが発生しました。")
 	}
 
 	return users, nil
 }
 
+// Role is the resolver for the role field.
+func (r *userResolver) Role(ctx context.Context, obj *model.User) (model.Role, error) {
+	role, err := r.Roles.GetRole(ctx, obj.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get user role", "error", err)
+
+		return "", apperror.Internal("ユーザーの権限の取得中にサーバーエラーが発生しました。")
+	}
+	return directive.RoleToGraph(role), nil
+}
+
 // CreatedAt is the resolver for the createdAt field.
 func (r *userResolver) CreatedAt(ctx context.Context, obj *model.User) (string, error) {
 	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
@@ -6,10 +6,14 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/loader"
 	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
 )
 
 // CreateWorkEvent is the resolver for the createWorkEvent field.
@@ -29,7 +33,11 @@ func (r *queryResolver) WorkEventsByEventID(ctx context.Context, eventID string)
 
 // ID is the resolver for the id field.
 func (r *workEventResolver) ID(ctx context.Context, obj *model.WorkEvent) (int32, error) {
-	panic(fmt.Errorf("not implemented: ID - id"))
+	id, err := strconv.ParseInt(obj.ID, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid work event id %q: %w", obj.ID, err)
+	}
+	return int32(id), nil
 }
 
 // CreatedAt is the resolver for the createdAt field.
@@ -44,7 +52,26 @@ func (r *workEventResolver) UpdatedAt(ctx context.Context, obj *model.WorkEvent)
 
 // Works is the resolver for the works field.
 func (r *workEventResolver) Works(ctx context.Context, obj *model.WorkEvent) ([]*model.Work, error) {
-	return []*model.Work{}, nil
+	work, err := r.loaders(ctx).Work.Load(ctx, obj.WorkID)
+	if errors.Is(err, loader.ErrNotFound) {
+		return []*model.Work{}, nil
+	}
+	if err != nil {
+		return nil, loadFailed(ctx, "作品の取得に失敗しました。", err, "work_id", obj.WorkID)
+	}
+	return []*model.Work{work}, nil
+}
+
+// Event is the resolver for the event field.
+func (r *workEventResolver) Event(ctx context.Context, obj *model.WorkEvent) (*model.Event, error) {
+	event, err := r.loaders(ctx).Event.Load(ctx, obj.EventID)
+	if errors.Is(err, loader.ErrNotFound) {
+		return nil, apperror.NotFound("イベントが見つかりません。")
+	}
+	if err != nil {
+		return nil, loadFailed(ctx, "イベントの取得に失敗しました。", err, "event_id", obj.EventID)
+	}
+	return event, nil
 }
 
 // WorkEvent returns graph.WorkEventResolver implementation.
@@ -0,0 +1,32 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+)
+
+// IDから作品プロフィールを取得
+func (r *Resolver) workProfileByID(ctx context.Context, id string) (*model.WorkProfile, error) {
+	query := `
+		SELECT id, work_id, profile_id, created_at, updated_at
+		FROM work_profiles
+		WHERE id = ?
+	`
+	wp := &model.WorkProfile{}
+	err := r.DB.QueryRowContext(ctx, query, id).Scan(&wp.ID, &wp.WorkID, &wp.ProfileID, &wp.CreatedAt, &wp.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			slog.InfoContext(ctx, "work profile not found", "id", id)
+
+			return nil, apperror.NotFound("作品が見つかりませんでした。")
+		}
+		slog.ErrorContext(ctx, "failed to scan work profile", "error", err)
+
+		return nil, apperror.Internal("作品のプロフィール取得中にサーバーエラーが発生しました。")
+	}
+	return wp, nil
+}
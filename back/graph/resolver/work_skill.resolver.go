@@ -6,14 +6,22 @@ package resolver
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/noonyuu/nfc/back/graph"
 	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/apperror"
 )
 
 // CreateWorkSkill is the resolver for the createWorkSkill field.
 func (r *mutationResolver) CreateWorkSkill(ctx context.Context, input model.NewWorkSkill) (*model.WorkSkill, error) {
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Authz.CanEditWork(ctx, userID, input.WorkID)
+	}); err != nil {
+		return nil, err
+	}
+
 	// 現在時刻を取得
 	now := time.Now()
 	// WorkSkill構造体にUUIDと現在時刻をセット
@@ -29,7 +37,9 @@ func (r *mutationResolver) CreateWorkSkill(ctx context.Context, input model.NewW
 	VALUES (?, ?, ?, ?)
 `
 	if _, err := r.DB.Exec(query, workSkill.WorkID, workSkill.SkillID, now, now); err != nil {
-		return nil, err
+		slog.ErrorContext(ctx, "failed to insert work skill", "error", err)
+
+		return nil, apperror.Internal("スキルの登録に失敗しました。")
 	}
 
 	return workSkill, nil
@@ -37,11 +47,19 @@ func (r *mutationResolver) CreateWorkSkill(ctx context.Context, input model.NewW
 
 // DeleteWorkSkill is the resolver for the deleteWorkSkill field.
 func (r *mutationResolver) DeleteWorkSkill(ctx context.Context, id int32) (*model.WorkSkill, error) {
+	if err := r.authorize(ctx, func(userID string) error {
+		return r.Authz.CanEditWorkSkill(ctx, userID, id)
+	}); err != nil {
+		return nil, err
+	}
+
 	query := `
 	DELETE FROM work_skills WHERE id = ?
 `
 	if _, err := r.DB.Exec(query, id); err != nil {
-		return nil, err
+		slog.ErrorContext(ctx, "failed to delete work skill", "error", err)
+
+		return nil, apperror.Internal("スキルの削除に失敗しました。")
 	}
 
 	return &model.WorkSkill{ID: id}, nil
@@ -57,14 +75,18 @@ func (r *queryResolver) WorkSkillsByWorkID(ctx context.Context, workID string) (
 	workSkills := []*model.WorkSkill{}
 	rows, err := r.DB.Query(query, workID)
 	if err != nil {
-		return nil, err
+		slog.ErrorContext(ctx, "failed to query work skills", "error", err)
+
+		return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
 	}
 	defer rows.Close()
 
 	for rows.Next() {
 		workSkill := &model.WorkSkill{}
 		if err := rows.Scan(&workSkill.ID, &workSkill.WorkID, &workSkill.SkillID, &workSkill.CreatedAt, &workSkill.UpdatedAt); err != nil {
-			return nil, err
+			slog.ErrorContext(ctx, "failed to scan work skill", "error", err)
+
+			return nil, apperror.Internal("スキルの取得中にサーバーエラーが発生しました。")
 		}
 		workSkills = append(workSkills, workSkill)
 	}
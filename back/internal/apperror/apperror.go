@@ -0,0 +1,190 @@
+package apperror
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// クライアントに返すエラーコード（extensions.code）
+const (
+	CodeBadUserInput    = "BAD_USER_INPUT"
+	CodeNotFound        = "NOT_FOUND"
+	CodeConflict        = "CONFLICT"
+	CodeUnauthenticated = "UNAUTHENTICATED"
+	CodeForbidden       = "FORBIDDEN"
+	CodeInternal        = "INTERNAL_SERVER_ERROR"
+)
+
+// クライアントに返すエラー
+// メッセージは日本語で指定し、英語はリクエストの言語に合わせてプレゼンターで翻訳する
+// 原因のエラー（Wrap）はログやトレースには残すが、クライアントには返さない
+type Error struct {
+	Code string
+	// 入力エラーの項目（Validationのみ）
+	Fields []FieldError
+
+	message    message
+	extensions map[string]interface{}
+	cause      error
+}
+
+// 入力エラーの項目
+// Pathは引数からのドット区切りのパス（例: input.title、input.userIds.0）
+type FieldError struct {
+	Path    string
+	message message
+}
+
+type message struct {
+	format string
+	args   []any
+}
+
+func (m message) in(lang Language) string {
+	format := m.format
+	if lang == English {
+		en, ok := english[m.format]
+		if !ok {
+			return ""
+		}
+		format = en
+	}
+	if len(m.args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, m.args...)
+}
+
+// 任意のコードのエラー
+func New(code, format string, args ...any) *Error {
+	return &Error{Code: code, message: message{format: format, args: args}}
+}
+
+// 対象が見つからない
+func NotFound(format string, args ...any) *Error {
+	return New(CodeNotFound, format, args...)
+}
+
+// 現在の状態と矛盾するため実行できない（最後の管理者の降格など）
+func Conflict(format string, args ...any) *Error {
+	return New(CodeConflict, format, args...)
+}
+
+// 未認証
+func Unauthenticated(format string, args ...any) *Error {
+	return New(CodeUnauthenticated, format, args...)
+}
+
+// 権限がない
+func Forbidden(format string, args ...any) *Error {
+	return New(CodeForbidden, format, args...)
+}
+
+// サーバー側の失敗（原因はWrapで付け、クライアントには返さない）
+func Internal(format string, args ...any) *Error {
+	return New(CodeInternal, format, args...)
+}
+
+// 入力エラーの項目を作る
+func Field(path, format string, args ...any) FieldError {
+	return FieldError{Path: path, message: message{format: format, args: args}}
+}
+
+// 入力エラー（全ての項目をまとめて返す）
+// 項目が1件の場合は、その項目のメッセージをエラーのメッセージにする
+func Validation(fields ...FieldError) *Error {
+	e := &Error{Code: CodeBadUserInput, Fields: fields}
+	switch len(fields) {
+	case 0:
+		e.message = message{format: "入力内容に誤りがあります。"}
+	case 1:
+		e.message = fields[0].message
+	default:
+		e.message = message{format: "入力内容に%d件の誤りがあります。", args: []any{len(fields)}}
+	}
+	return e
+}
+
+// 1項目の入力エラー
+func Invalid(path, format string, args ...any) *Error {
+	return Validation(Field(path, format, args...))
+}
+
+// 原因のエラーを付ける
+func (e *Error) Wrap(err error) *Error {
+	e.cause = err
+	return e
+}
+
+// extensionsに値を追加する（codeなどの既定の値は上書きできない）
+func (e *Error) With(key string, value interface{}) *Error {
+	if e.extensions == nil {
+		e.extensions = map[string]interface{}{}
+	}
+	e.extensions[key] = value
+	return e
+}
+
+// ログ・トレース用の文字列（日本語のメッセージと原因を含む）
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Code)
+	b.WriteString(": ")
+	b.WriteString(e.message.in(Japanese))
+	for _, f := range e.Fields {
+		fmt.Fprintf(&b, " [%s: %s]", f.Path, f.message.in(Japanese))
+	}
+	if e.cause != nil {
+		b.WriteString(": ")
+		b.WriteString(e.cause.Error())
+	}
+	return b.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// 指定した言語のメッセージ
+func (e *Error) Message(lang Language) string {
+	if msg := e.message.in(lang); msg != "" {
+		return msg
+	}
+	return fallback(e.Code)
+}
+
+// 指定した言語のextensions
+func (e *Error) Extensions(lang Language) map[string]interface{} {
+	ext := make(map[string]interface{}, len(e.extensions)+2)
+	for k, v := range e.extensions {
+		ext[k] = v
+	}
+	ext["code"] = e.Code
+	if len(e.Fields) > 0 {
+		fields := make([]map[string]interface{}, len(e.Fields))
+		for i, f := range e.Fields {
+			msg := f.message.in(lang)
+			if msg == "" {
+				msg = fallback(CodeBadUserInput)
+			}
+			fields[i] = map[string]interface{}{
+				"path":    f.Path,
+				"message": msg,
+			}
+		}
+		ext["fields"] = fields
+	}
+	return ext
+}
+
+// *gqlerror.Error を返す必要がある箇所（OperationContextMutatorなど）で使う
+// 元のエラーを保持するため、プレゼンターでリクエストの言語に変換される
+func (e *Error) GQLError() *gqlerror.Error {
+	return &gqlerror.Error{
+		Err:        e,
+		Message:    e.Message(Japanese),
+		Extensions: e.Extensions(Japanese),
+	}
+}
@@ -0,0 +1,70 @@
+package apperror
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// エラーメッセージの言語
+type Language string
+
+const (
+	Japanese Language = "ja"
+	English  Language = "en"
+)
+
+type languageKey struct{}
+
+func WithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, languageKey{}, lang)
+}
+
+// contextの言語（未設定の場合は日本語）
+func LanguageFromContext(ctx context.Context) Language {
+	if lang, ok := ctx.Value(languageKey{}).(Language); ok {
+		return lang
+	}
+	return Japanese
+}
+
+// Accept-Languageのうち、対応している言語で最も優先度の高いものを返す
+// 対応している言語がない場合は日本語にする
+func ParseAcceptLanguage(header string) Language {
+	best, bestQ := Japanese, 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+
+		base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		var lang Language
+		switch base {
+		case "ja":
+			lang = Japanese
+		case "en":
+			lang = English
+		default:
+			continue
+		}
+		if q > bestQ {
+			best, bestQ = lang, q
+		}
+	}
+	return best
+}
+
+// リクエストのAccept-Languageからエラーメッセージの言語をcontextに設定する
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lang := ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+		next.ServeHTTP(w, r.WithContext(WithLanguage(r.Context(), lang)))
+	})
+}
@@ -0,0 +1,58 @@
+package apperror
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   Language
+	}{
+		{"", Japanese},
+		{"en", English},
+		{"ja", Japanese},
+		// 地域のサブタグは無視する
+		{"en-US", English},
+		{"EN-gb", English},
+		{"ja-JP,ja;q=0.9", Japanese},
+		// q値の大きい方を選ぶ
+		{"ja;q=0.5, en;q=0.8", English},
+		{"en-US;q=0.7, ja-JP", Japanese},
+		{"en;q=0.8, ja;q=0.8", English},
+		// 対応していない言語は読み飛ばす
+		{"fr-FR, en;q=0.5", English},
+		{"zh-CN, ko", Japanese},
+		{"*", Japanese},
+		// q値が不正な言語は読み飛ばす
+		{"en;q=high, ja;q=0.1", Japanese},
+		{"en;q=0", Japanese},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := ParseAcceptLanguage(tt.header); got != tt.want {
+				t.Errorf("ParseAcceptLanguage(%q) = %s, want %s", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var got Language
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = LanguageFromContext(r.Context())
+	}))
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	if got != English {
+		t.Errorf("language = %s, want en", got)
+	}
+
+	if lang := LanguageFromContext(context.Background()); lang != Japanese {
+		t.Errorf("default language = %s, want ja", lang)
+	}
+}
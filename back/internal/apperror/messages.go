@@ -0,0 +1,136 @@
+package apperror
+
+// 日本語のメッセージ（書式）に対応する英語のメッセージ
+// 新しいメッセージを追加した場合はここにも追加する（ない場合はコードごとの既定のメッセージになる）
+var english = map[string]string{
+	// 共通
+	"サーバーエラーが発生しました。":                                    "An internal server error occurred.",
+	"失敗しました。":                                            "The operation failed.",
+	"データの取得中にサーバーエラーが発生しました。":                            "An internal server error occurred while fetching the data.",
+	"入力内容に誤りがあります。":                                      "The input is invalid.",
+	"入力内容に%d件の誤りがあります。":                                  "The input has %d errors.",
+	"更新するフィールドが指定されていません。":                               "No fields to update were specified.",
+	"取得件数は1件以上を指定してください。":                                "The number of items must be at least 1.",
+	"取得件数は1〜%d件で指定してください。":                               "The number of items must be between 1 and %d.",
+	"無効なafterカーソルです。":                                    "The after cursor is invalid.",
+	"無効なbeforeカーソルです。":                                   "The before cursor is invalid.",
+	"カーソルのエンコード中にサーバーエラーが発生しました。":                        "An internal server error occurred while encoding the cursor.",
+	"日付の形式が正しくありません。'YYYY-MM-DD HH:MM:SS' の形式で入力してください。": "The date format is invalid. Use 'YYYY-MM-DD HH:MM:SS'.",
+	"日時の形式が不正です。":                                        "The date and time format is invalid.",
+	"操作対象が見つかりません。":                                      "The target of the operation was not found.",
+	"IDの形式が正しくありません。":                                    "The ID format is invalid.",
+	"リアルタイム通知を開始できませんでした。":                               "Could not start the real-time subscription.",
+
+	// 入力値の検証（graph/directive/validate.go、graph/model/validate.go）
+	"%d文字以上で入力してください。":    "Must be at least %d characters.",
+	"%d文字以内で入力してください。":    "Must be at most %d characters.",
+	"URLの形式が正しくありません。":    "Must be a valid http or https URL.",
+	"%d以上の値を入力してください。":    "Must be at least %d.",
+	"%d以下の値を入力してください。":    "Must be at most %d.",
+	"形式が正しくありません。":        "The format is invalid.",
+	"終了日時は開始日時以降にしてください。": "The end date must not be before the start date.",
+	"同じ値が重複しています。":        "The value is duplicated.",
+
+	// 認証・認可・リクエストの制限
+	"ログインが必要です。":                      "You must be logged in.",
+	"この操作を行う権限がありません。":                "You do not have permission to perform this action.",
+	"GETリクエストではミューテーションを実行できません。":     "Mutations cannot be executed with a GET request.",
+	"リクエストが多すぎます。しばらくしてから再度お試しください。":  "Too many requests. Please try again later.",
+	"許可されていないクエリです。":                  "This query is not allowed.",
+	"クエリのネストが深すぎます（%d階層、上限は%d階層）。":    "The query is nested too deeply (%d levels, the limit is %d).",
+	"権限の確認中にサーバーエラーが発生しました。":          "An internal server error occurred while checking permissions.",
+	"権限の変更中にサーバーエラーが発生しました。":          "An internal server error occurred while changing the role.",
+	"ユーザーの権限の取得中にサーバーエラーが発生しました。":     "An internal server error occurred while fetching the user's role.",
+	"不正な権限です。":                        "The role is invalid.",
+	"最後の管理者の権限は変更できません。":              "The role of the last administrator cannot be changed.",
+	"最後のログイン方法は解除できません。":              "The last login method cannot be unlinked.",
+	"連携されていないログイン方法です。":               "The login method is not linked.",
+	"連携済みのログイン方法の取得中にサーバーエラーが発生しました。": "An internal server error occurred while fetching the linked login methods.",
+	"ログイン方法の解除中にサーバーエラーが発生しました。":      "An internal server error occurred while unlinking the login method.",
+	"セッション一覧の取得中にサーバーエラーが発生しました。":     "An internal server error occurred while fetching the sessions.",
+	"セッションの失効中にサーバーエラーが発生しました。":       "An internal server error occurred while revoking the session.",
+	"トークン一覧の取得中にサーバーエラーが発生しました。":      "An internal server error occurred while fetching the tokens.",
+	"トークンの作成中にサーバーエラーが発生しました。":        "An internal server error occurred while creating the token.",
+	"トークンの名前・スコープ・有効期限が不正です。":         "The token name, scopes or expiration is invalid.",
+	"監査ログの取得中にサーバーエラーが発生しました。":        "An internal server error occurred while fetching the audit logs.",
+
+	// ユーザー・プロフィール・公開設定
+	"ユーザーが見つかりません。":                "User not found.",
+	"ユーザーの取得に失敗しました。":              "Failed to fetch the user.",
+	"ユーザーの取得中にサーバーエラーが発生しました。":     "An internal server error occurred while fetching the user.",
+	"ユーザーの登録に失敗しました。":              "Failed to register the user.",
+	"プロフィールが見つかりません。":              "Profile not found.",
+	"指定されたプロフィールが見つかりません。":         "The specified profile was not found.",
+	"プロフィールの取得に失敗しました。":            "Failed to fetch the profile.",
+	"プロフィールの取得中にサーバーエラーが発生しました。":   "An internal server error occurred while fetching the profile.",
+	"プロフィールの作成中にサーバーエラーが発生しました。":   "An internal server error occurred while creating the profile.",
+	"プロフィールの更新中にサーバーエラーが発生しました。":   "An internal server error occurred while updating the profile.",
+	"プロフィールの作品取得に失敗しました。":          "Failed to fetch the profile's works.",
+	"プロフィールの作品取得中にサーバーエラーが発生しました。": "An internal server error occurred while fetching the profile's works.",
+	"公開設定の取得中にサーバーエラーが発生しました。":     "An internal server error occurred while fetching the privacy settings.",
+	"公開設定の更新中にサーバーエラーが発生しました。":     "An internal server error occurred while updating the privacy settings.",
+	"公開設定の確認中にサーバーエラーが発生しました。":     "An internal server error occurred while checking the privacy settings.",
+	"不正な公開範囲です。":                   "The visibility is invalid.",
+
+	// 作品
+	"作品が見つかりません。":                  "Work not found.",
+	"作品が見つかりませんでした。":               "Work not found.",
+	"ID '%s' の作品が見つかりません。":         "Work with ID '%s' not found.",
+	"作品の取得に失敗しました。":                "Failed to fetch the work.",
+	"作品の取得中にサーバーエラーが発生しました。":       "An internal server error occurred while fetching the work.",
+	"作品の登録に失敗しました。":                "Failed to register the work.",
+	"作品の更新に失敗しました。":                "Failed to update the work.",
+	"作品の更新を完了できませんでした。":            "Could not complete the work update.",
+	"作品の削除に失敗しました。":                "Failed to delete the work.",
+	"作品画像の更新に失敗しました。":              "Failed to update the work images.",
+	"構成図の更新に失敗しました。":               "Failed to update the diagrams.",
+	"共同制作者の更新に失敗しました。":             "Failed to update the collaborators.",
+	"技術スタックの更新に失敗しました。":            "Failed to update the tech stack.",
+	"画像の取得に失敗しました。":                "Failed to fetch the images.",
+	"画像の登録に失敗しました。":                "Failed to register the image.",
+	"図の画像の取得に失敗しました。":              "Failed to fetch the diagram images.",
+	"図の画像の登録に失敗しました。":              "Failed to register the diagram image.",
+	"作品のプロフィール取得に失敗しました。":          "Failed to fetch the work's profiles.",
+	"作品のプロフィール取得中にサーバーエラーが発生しました。": "An internal server error occurred while fetching the work's profiles.",
+	"プロジェクトイベントの作成に失敗しました。":        "Failed to create the project event.",
+
+	// イベント
+	"イベントが見つかりません。":            "Event not found.",
+	"イベントが提供されていません。":          "No event was specified.",
+	"イベントの取得に失敗しました。":          "Failed to fetch the event.",
+	"イベントの取得中にサーバーエラーが発生しました。": "An internal server error occurred while fetching the event.",
+	"イベントの作成中にサーバーエラーが発生しました。": "An internal server error occurred while creating the event.",
+	"イベントの更新中にサーバーエラーが発生しました。": "An internal server error occurred while updating the event.",
+	"イベントの削除中にサーバーエラーが発生しました。": "An internal server error occurred while deleting the event.",
+	"イベントの登録に失敗しました。":          "Failed to register the event.",
+	"運営者の追加中にサーバーエラーが発生しました。":  "An internal server error occurred while adding the organizer.",
+	"運営者の削除中にサーバーエラーが発生しました。":  "An internal server error occurred while removing the organizer.",
+
+	// スキル
+	"スキルが見つかりません。":            "Skill not found.",
+	"スキルの取得に失敗しました。":          "Failed to fetch the skills.",
+	"スキルの取得中にサーバーエラーが発生しました。": "An internal server error occurred while fetching the skills.",
+	"スキルの登録に失敗しました。":          "Failed to register the skill.",
+	"スキルの更新中にサーバーエラーが発生しました。": "An internal server error occurred while updating the skill.",
+	"スキルの削除に失敗しました。":          "Failed to delete the skill.",
+	"スキルの削除中にサーバーエラーが発生しました。": "An internal server error occurred while deleting the skill.",
+}
+
+// 英語のメッセージがない場合のコードごとの既定のメッセージ
+func fallback(code string) string {
+	switch code {
+	case CodeBadUserInput:
+		return "The input is invalid."
+	case CodeNotFound:
+		return "The requested resource was not found."
+	case CodeConflict:
+		return "The request conflicts with the current state."
+	case CodeUnauthenticated:
+		return "You must be logged in."
+	case CodeForbidden:
+		return "You do not have permission to perform this action."
+	case CodeInternal:
+		return "An internal server error occurred."
+	}
+	return "The request could not be processed."
+}
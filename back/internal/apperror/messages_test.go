@@ -0,0 +1,189 @@
+package apperror
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// メッセージ（書式）を受け取る関数と、その引数の位置
+var messageFuncs = map[string]int{
+	"New":             1,
+	"NotFound":        0,
+	"Conflict":        0,
+	"Unauthenticated": 0,
+	"Forbidden":       0,
+	"Internal":        0,
+	"Field":           1,
+	"Invalid":         1,
+}
+
+// メッセージをそのままapperrorに渡す関数（graph/resolver/loader.go）
+var messageWrappers = map[string]int{
+	"loadFailed": 1,
+}
+
+var verbPattern = regexp.MustCompile(`%[a-z]`)
+
+// モジュール内でエラーメッセージに使われている書式を集める
+func usedMessages(t *testing.T, root string) map[string]string {
+	t.Helper()
+	fset := token.NewFileSet()
+	pkgs := map[string][]*ast.File{}
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && (d.Name() == "node_modules" || strings.HasPrefix(d.Name(), ".")) {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		pkgs[filepath.Dir(path)] = append(pkgs[filepath.Dir(path)], f)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	used := map[string]string{}
+	for _, files := range pkgs {
+		// 同じパッケージの文字列の定数
+		consts := map[string]string{}
+		for _, f := range files {
+			for _, decl := range f.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					vs := spec.(*ast.ValueSpec)
+					for i, name := range vs.Names {
+						if i < len(vs.Values) {
+							if s, ok := stringLit(vs.Values[i]); ok {
+								consts[name.Name] = s
+							}
+						}
+					}
+				}
+			}
+		}
+
+		for _, f := range files {
+			inApperror := f.Name.Name == "apperror"
+			for _, decl := range f.Decls {
+				fn, _ := decl.(*ast.FuncDecl)
+				ast.Inspect(decl, func(n ast.Node) bool {
+					var arg ast.Expr
+					switch n := n.(type) {
+					case *ast.CallExpr:
+						index, ok := messageArg(n, inApperror)
+						if !ok || index >= len(n.Args) {
+							return true
+						}
+						arg = n.Args[index]
+					case *ast.KeyValueExpr:
+						// apperror内のmessage{format: ...}
+						if key, ok := n.Key.(*ast.Ident); !inApperror || !ok || key.Name != "format" {
+							return true
+						}
+						arg = n.Value
+					default:
+						return true
+					}
+
+					pos := fset.Position(arg.Pos()).String()
+					if s, ok := stringLit(arg); ok {
+						used[s] = pos
+						return true
+					}
+					if ident, ok := arg.(*ast.Ident); ok {
+						if s, ok := consts[ident.Name]; ok {
+							used[s] = pos
+							return true
+						}
+						// 受け取ったメッセージを渡すだけの関数
+						if inApperror || (fn != nil && messageWrappers[fn.Name.Name] > 0) {
+							return true
+						}
+					}
+					t.Errorf("%s: the message must be a string literal or constant to be translated", pos)
+					return true
+				})
+			}
+		}
+	}
+	return used
+}
+
+// メッセージを受け取る引数の位置
+func messageArg(call *ast.CallExpr, inApperror bool) (int, bool) {
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		if pkg, ok := fun.X.(*ast.Ident); ok && pkg.Name == "apperror" {
+			index, ok := messageFuncs[fun.Sel.Name]
+			return index, ok
+		}
+	case *ast.Ident:
+		if index, ok := messageWrappers[fun.Name]; ok {
+			return index, true
+		}
+		if inApperror {
+			index, ok := messageFuncs[fun.Name]
+			return index, ok
+		}
+	}
+	return 0, false
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	return s, err == nil
+}
+
+// 使われている全てのメッセージに英語のメッセージがある
+func TestEnglishTranslationCoverage(t *testing.T) {
+	root, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "go.mod")); err != nil {
+		t.Fatalf("module root not found: %v", err)
+	}
+
+	used := usedMessages(t, root)
+	// 探索の漏れで検査が素通りしないよう、既知のメッセージが見つかることを確認する
+	for _, known := range []string{"IDの形式が正しくありません。", "入力内容に%d件の誤りがあります。", "同じ値が重複しています。"} {
+		if _, ok := used[known]; !ok {
+			t.Errorf("message %q was not found in the source", known)
+		}
+	}
+
+	for format, pos := range used {
+		en, ok := english[format]
+		if !ok {
+			t.Errorf("%s: no English message for %q", pos, format)
+			continue
+		}
+		// 引数の数と種類が同じ
+		if ja, e := verbPattern.FindAllString(format, -1), verbPattern.FindAllString(en, -1); strings.Join(ja, "") != strings.Join(e, "") {
+			t.Errorf("%s: verbs of %q (%v) and %q (%v) differ", pos, format, ja, en, e)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package apperror
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// gqlgenのエラープレゼンター
+// *Error はリクエストの言語のメッセージとコードに変換する
+// それ以外のリゾルバーのエラーは内部の詳細を隠し、ログに残して INTERNAL_SERVER_ERROR にする
+// gqlgen自身のエラー（構文・検証エラーなど）はそのまま返す
+func Present(ctx context.Context, err error) *gqlerror.Error {
+	lang := LanguageFromContext(ctx)
+
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		presented := *graphql.DefaultErrorPresenter(ctx, err)
+		presented.Message = appErr.Message(lang)
+		presented.Extensions = appErr.Extensions(lang)
+		return &presented
+	}
+
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		return graphql.DefaultErrorPresenter(ctx, err)
+	}
+
+	slog.ErrorContext(ctx, "unexpected graphql error", "path", graphql.GetPath(ctx).String(), "error", err)
+
+	internal := Internal("サーバーエラーが発生しました。").Wrap(err)
+	presented := *graphql.DefaultErrorPresenter(ctx, err)
+	presented.Message = internal.Message(lang)
+	presented.Extensions = internal.Extensions(lang)
+	return &presented
+}
+
+// オペレーションの実行前に拒否するレスポンス
+// AroundOperationsで返すエラーにはプレゼンターが適用されないため、ここで変換する
+func Reject(ctx context.Context, err *Error) graphql.ResponseHandler {
+	return graphql.OneShot(&graphql.Response{Errors: gqlerror.List{Present(ctx, err)}})
+}
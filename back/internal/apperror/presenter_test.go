@@ -0,0 +1,89 @@
+package apperror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestPresent(t *testing.T) {
+	ja := context.Background()
+	en := WithLanguage(context.Background(), English)
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		err         error
+		wantMessage string
+		wantCode    string
+	}{
+		{"japanese", ja, NotFound("作品が見つかりません。"), "作品が見つかりません。", CodeNotFound},
+		{"english", en, NotFound("作品が見つかりません。"), "Work not found.", CodeNotFound},
+		{"english with arguments", en, NotFound("ID '%s' の作品が見つかりません。", "w1"), "Work with ID 'w1' not found.", CodeNotFound},
+		// 英語のメッセージがない場合はコードごとの既定のメッセージ
+		{"untranslated", en, Conflict("未翻訳のメッセージ"), "The request conflicts with the current state.", CodeConflict},
+		{"wrapped", en, fmt.Errorf("resolver: %w", Forbidden("この操作を行う権限がありません。")), "You do not have permission to perform this action.", CodeForbidden},
+		// apperror以外のエラーは詳細を隠す
+		{"internal error is hidden", ja, errors.New("dial tcp 10.0.0.5:3306: connection refused"), "サーバーエラーが発生しました。", CodeInternal},
+		{"internal error is hidden in english", en, fmt.Errorf("query failed: %w", errors.New("secret detail")), "An internal server error occurred.", CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Present(tt.ctx, tt.err)
+			if got.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", got.Message, tt.wantMessage)
+			}
+			if got.Extensions["code"] != tt.wantCode {
+				t.Errorf("code = %v, want %s", got.Extensions["code"], tt.wantCode)
+			}
+		})
+	}
+}
+
+// 原因のエラーはクライアントに返さない
+func TestPresentHidesCause(t *testing.T) {
+	cause := errors.New("Error 1062: Duplicate entry 'alice@example.com'")
+	for _, err := range []error{cause, Internal("作品の登録に失敗しました。").Wrap(cause)} {
+		got := Present(context.Background(), err)
+		if strings.Contains(got.Message, "Duplicate") || strings.Contains(fmt.Sprint(got.Extensions), "Duplicate") {
+			t.Errorf("presented error leaks the cause: %+v", got)
+		}
+	}
+}
+
+// gqlgen自身のエラーはそのまま返す
+func TestPresentKeepsGraphQLErrors(t *testing.T) {
+	err := gqlerror.Errorf("Cannot query field \"foo\" on type \"Query\".")
+	got := Present(WithLanguage(context.Background(), English), err)
+	if got.Message != err.Message || got.Extensions["code"] != nil {
+		t.Errorf("Present() = %+v, want the gqlgen error unchanged", got)
+	}
+}
+
+func TestPresentValidationFields(t *testing.T) {
+	err := Validation(
+		Field("input.title", "%d文字以内で入力してください。", 100),
+		Field("input.url", "未翻訳のメッセージ"),
+	)
+	got := Present(WithLanguage(context.Background(), English), err)
+	if got.Message != "The input has 2 errors." {
+		t.Errorf("message = %q", got.Message)
+	}
+	want := []map[string]interface{}{
+		{"path": "input.title", "message": "Must be at most 100 characters."},
+		{"path": "input.url", "message": "The input is invalid."},
+	}
+	if !reflect.DeepEqual(got.Extensions["fields"], want) {
+		t.Errorf("fields = %v, want %v", got.Extensions["fields"], want)
+	}
+
+	// 1項目の場合はその項目のメッセージ
+	if got := Present(context.Background(), Invalid("id", "IDの形式が正しくありません。")); got.Message != "IDの形式が正しくありません。" {
+		t.Errorf("message = %q", got.Message)
+	}
+}
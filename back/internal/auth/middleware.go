@@ -0,0 +1,127 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/config"
+)
+
+// パーソナルアクセストークンの接頭辞（JWTと区別するため、また漏洩時に検出しやすくするため）
+const PersonalAccessTokenPrefix = "hmpat_"
+
+// 端末セッションが失効していないかを確認する
+type SessionChecker interface {
+	IsDeviceSessionActive(ctx context.Context, userID string, sessionID string) (bool, error)
+}
+
+// パーソナルアクセストークンを検証する
+type TokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*model.PersonalAccessToken, error)
+	// トークンが削除・期限切れになっていないか
+	IsActive(ctx context.Context, userID string, tokenID string) (bool, error)
+}
+
+// アクセストークンを検証し、認証済みユーザーをリクエストコンテキストに保存するミドルウェア
+// トークンが無い・無効な場合や、端末セッションが失効している場合は未認証のまま次のハンドラーに渡す
+func Middleware(sessions SessionChecker, tokens TokenAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if viewer := authenticate(r, sessions, tokens); viewer != nil {
+				r = r.WithContext(WithViewer(r.Context(), viewer))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func authenticate(r *http.Request, sessions SessionChecker, tokens TokenAuthenticator) *Viewer {
+	// パーソナルアクセストークンはAuthorizationヘッダーでのみ受け付ける
+	if token := bearerToken(r.Header.Get("Authorization")); token != "" {
+		return authenticateToken(r.Context(), token, sessions, tokens)
+	}
+	if cookie, err := r.Cookie("access_token"); err == nil && !strings.HasPrefix(cookie.Value, PersonalAccessTokenPrefix) {
+		return authenticateToken(r.Context(), cookie.Value, sessions, tokens)
+	}
+	return nil
+}
+
+// パーソナルアクセストークンまたはアクセストークン（JWT）を検証する
+func authenticateToken(ctx context.Context, token string, sessions SessionChecker, tokens TokenAuthenticator) *Viewer {
+	if token == "" {
+		return nil
+	}
+	if strings.HasPrefix(token, PersonalAccessTokenPrefix) {
+		if tokens == nil {
+			return nil
+		}
+		pat, err := tokens.Authenticate(ctx, token)
+		if err != nil {
+			return nil
+		}
+		viewer := NewTokenViewer(pat.UserID, pat.ID, pat.Scopes)
+		if pat.ExpiresAt != nil {
+			viewer.ExpiresAt = *pat.ExpiresAt
+		}
+		return viewer
+	}
+
+	claims, err := config.ParseAccessToken(token)
+	if err != nil || claims.Id == "" || !sessionActive(ctx, sessions, claims) {
+		return nil
+	}
+	viewer := &Viewer{UserID: claims.Id, SessionID: claims.SessionID}
+	if claims.ExpiresAt != nil {
+		viewer.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return viewer
+}
+
+// 接続時に認証したユーザーの認証がまだ有効か
+// WebSocketなどの長時間の接続で、ログアウト・セッションの失効・トークンの期限切れを検知する
+func StillAuthenticated(ctx context.Context, viewer *Viewer, sessions SessionChecker, tokens TokenAuthenticator) bool {
+	if !viewer.ExpiresAt.IsZero() && time.Now().After(viewer.ExpiresAt) {
+		return false
+	}
+	if viewer.IsToken() {
+		if tokens == nil {
+			return true
+		}
+		active, err := tokens.IsActive(ctx, viewer.UserID, viewer.TokenID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to check token", "error", err)
+			return false
+		}
+		return active
+	}
+	return sessionActive(ctx, sessions, &config.CustomClaims{Id: viewer.UserID, SessionID: viewer.SessionID})
+}
+
+// 端末セッションに紐づくトークンの場合、セッションが残っているかを確認
+func sessionActive(ctx context.Context, sessions SessionChecker, claims *config.CustomClaims) bool {
+	if claims.SessionID == "" || sessions == nil {
+		return true
+	}
+	active, err := sessions.IsDeviceSessionActive(ctx, claims.Id, claims.SessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check session", "error", err)
+		return false
+	}
+	return active
+}
+
+// AuthorizationヘッダーからBearerトークンを取得
+func bearerToken(header string) string {
+	if header == "" {
+		return ""
+	}
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,241 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/markbates/goth"
+	"github.com/markbates/goth/providers/discord"
+	"github.com/markbates/goth/providers/github"
+	"github.com/markbates/goth/providers/gitlab"
+	"github.com/markbates/goth/providers/google"
+	"github.com/markbates/goth/providers/openidConnect"
+	"gopkg.in/yaml.v3"
+)
+
+// 対応しているプロバイダーの種類
+const (
+	ProviderTypeGoogle  = "google"
+	ProviderTypeGitHub  = "github"
+	ProviderTypeDiscord = "discord"
+	ProviderTypeGitLab  = "gitlab"
+	ProviderTypeOIDC    = "oidc"
+)
+
+// 種類ごとのデフォルトのスコープ
+var defaultScopes = map[string][]string{
+	ProviderTypeGoogle:  {"email", "profile"},
+	ProviderTypeGitHub:  {"user:email", "read:user"},
+	ProviderTypeDiscord: {"identify", "email"},
+	ProviderTypeGitLab:  {"read_user"},
+	ProviderTypeOIDC:    {"openid", "email", "profile"},
+}
+
+// /api/v1/auth/ 配下で他のエンドポイントと衝突する名前
+var reservedProviderNames = map[string]bool{
+	"ping":      true,
+	"link":      true,
+	"getUser":   true,
+	"logout":    true,
+	"refresh":   true,
+	"providers": true,
+}
+
+// 認証プロバイダーの設定
+type ProviderConfig struct {
+	// URLやprovidersテーブルに保存される名前（例: google, school）
+	Name string `yaml:"name"`
+	// プロバイダーの種類（google, github, discord, gitlab, oidc）
+	Type string `yaml:"type"`
+	// ログイン画面に表示する名前
+	DisplayName  string   `yaml:"display_name"`
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	Scopes       []string `yaml:"scopes"`
+	// oidcの場合に指定（/.well-known/openid-configuration のURL）
+	DiscoveryURL string `yaml:"discovery_url"`
+	// oidcでディスカバリーを使わずにエンドポイントを直接指定する場合
+	AuthURL     string `yaml:"auth_url"`
+	TokenURL    string `yaml:"token_url"`
+	UserInfoURL string `yaml:"userinfo_url"`
+	IssuerURL   string `yaml:"issuer_url"`
+	// gitlabのセルフホスト環境の場合に指定（例: https://gitlab.example.com）
+	BaseURL string `yaml:"base_url"`
+	// falseの場合は読み込まない
+	Enabled *bool `yaml:"enabled"`
+}
+
+type providersFile struct {
+	Providers []ProviderConfig `yaml:"providers"`
+}
+
+// ログイン画面に表示するプロバイダーの情報
+type ProviderInfo struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	DisplayName string `json:"display_name"`
+}
+
+// 有効なプロバイダーの一覧（設定ファイルの記載順）
+var enabledProviders []ProviderInfo
+
+// 有効なプロバイダーの一覧を返す
+func EnabledProviders() []ProviderInfo {
+	return enabledProviders
+}
+
+// プロバイダーの設定ファイルを読み込む
+// ファイル内の ${ENV_NAME} は環境変数で置き換えるため、シークレットはファイルに直接書かない
+func LoadProviderConfigs(path string) ([]ProviderConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth providers file: %w", err)
+	}
+
+	var file providersFile
+	if err := yaml.Unmarshal([]byte(os.ExpandEnv(string(data))), &file); err != nil {
+		return nil, fmt.Errorf("failed to parse auth providers file: %w", err)
+	}
+
+	var configs []ProviderConfig
+	var errs []error
+	names := make(map[string]bool)
+	for i, p := range file.Providers {
+		if p.Enabled != nil && !*p.Enabled {
+			continue
+		}
+		if err := p.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("providers[%d]: %w", i, err))
+			continue
+		}
+		if names[p.Name] {
+			errs = append(errs, fmt.Errorf("providers[%d]: duplicate name %q", i, p.Name))
+			continue
+		}
+		names[p.Name] = true
+		configs = append(configs, p)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, errors.New("no auth providers are enabled")
+	}
+
+	return configs, nil
+}
+
+func (p *ProviderConfig) validate() error {
+	var errs []error
+	if p.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	} else if p.Name != url.PathEscape(p.Name) || reservedProviderNames[p.Name] {
+		errs = append(errs, fmt.Errorf("name %q cannot be used in a URL path", p.Name))
+	}
+	if _, ok := defaultScopes[p.Type]; !ok {
+		errs = append(errs, fmt.Errorf("unsupported type %q", p.Type))
+	}
+	if p.ClientID == "" {
+		errs = append(errs, errors.New("client_id is required"))
+	}
+	if p.ClientSecret == "" {
+		errs = append(errs, errors.New("client_secret is required"))
+	}
+	if p.Type == ProviderTypeOIDC && p.DiscoveryURL == "" && (p.AuthURL == "" || p.TokenURL == "" || p.IssuerURL == "") {
+		errs = append(errs, errors.New("discovery_url or auth_url, token_url and issuer_url are required for oidc"))
+	}
+	if p.BaseURL != "" && p.Type != ProviderTypeGitLab {
+		errs = append(errs, errors.New("base_url is only supported for gitlab"))
+	}
+	return errors.Join(errs...)
+}
+
+// 設定からgothのプロバイダーを生成する
+// oidcの場合はディスカバリーURLへのリクエストが発生する
+func (p *ProviderConfig) build(hostURL string) (goth.Provider, error) {
+	callbackURL, err := url.JoinPath(hostURL, "api/v1/auth", p.Name, "callback")
+	if err != nil {
+		return nil, fmt.Errorf("failed to build callback url: %w", err)
+	}
+
+	scopes := p.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes[p.Type]
+	}
+
+	switch p.Type {
+	case ProviderTypeGoogle:
+		provider := google.New(p.ClientID, p.ClientSecret, callbackURL, scopes...)
+		provider.SetName(p.Name)
+		return provider, nil
+	case ProviderTypeGitHub:
+		provider := github.New(p.ClientID, p.ClientSecret, callbackURL, scopes...)
+		provider.SetName(p.Name)
+		return provider, nil
+	case ProviderTypeDiscord:
+		provider := discord.New(p.ClientID, p.ClientSecret, callbackURL, scopes...)
+		provider.SetName(p.Name)
+		return provider, nil
+	case ProviderTypeGitLab:
+		var provider *gitlab.Provider
+		if p.BaseURL != "" {
+			base := strings.TrimSuffix(p.BaseURL, "/")
+			provider = gitlab.NewCustomisedURL(p.ClientID, p.ClientSecret, callbackURL,
+				base+"/oauth/authorize", base+"/oauth/token", base+"/api/v4/user", scopes...)
+		} else {
+			provider = gitlab.New(p.ClientID, p.ClientSecret, callbackURL, scopes...)
+		}
+		provider.SetName(p.Name)
+		return provider, nil
+	case ProviderTypeOIDC:
+		if p.DiscoveryURL == "" {
+			provider, err := openidConnect.NewCustomisedURL(p.ClientID, p.ClientSecret, callbackURL,
+				p.AuthURL, p.TokenURL, p.IssuerURL, p.UserInfoURL, "", scopes...)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create oidc provider %s: %w", p.Name, err)
+			}
+			provider.SetName(p.Name)
+			return provider, nil
+		}
+		provider, err := openidConnect.NewNamed(p.Name, p.ClientID, p.ClientSecret, callbackURL, p.DiscoveryURL, scopes...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover oidc provider %s: %w", p.Name, err)
+		}
+		if provider.OpenIDConfig.AuthEndpoint == "" || provider.OpenIDConfig.TokenEndpoint == "" {
+			return nil, fmt.Errorf("oidc provider %s: discovery document has no authorization or token endpoint", p.Name)
+		}
+		// NewNamedは名前に"-oidc"を付けるため、URLの名前に戻す
+		provider.SetName(p.Name)
+		return provider, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %q", p.Type)
+	}
+}
+
+// 設定されたプロバイダーをgothに登録する
+func UseProviders(configs []ProviderConfig, hostURL string) error {
+	providers := make([]goth.Provider, 0, len(configs))
+	infos := make([]ProviderInfo, 0, len(configs))
+	for _, c := range configs {
+		provider, err := c.build(hostURL)
+		if err != nil {
+			return err
+		}
+		providers = append(providers, provider)
+
+		displayName := c.DisplayName
+		if displayName == "" {
+			displayName = c.Name
+		}
+		infos = append(infos, ProviderInfo{Name: c.Name, Type: c.Type, DisplayName: displayName})
+	}
+
+	goth.ClearProviders()
+	goth.UseProviders(providers...)
+	enabledProviders = infos
+
+	return nil
+}
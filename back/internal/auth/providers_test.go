@@ -0,0 +1,300 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/markbates/goth"
+)
+
+// 設定ファイルを一時ディレクトリに書き出す
+func writeProvidersFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "auth_providers.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadProviderConfigs(t *testing.T) {
+	t.Setenv("TEST_GOOGLE_CLIENT_ID", "google-id")
+	t.Setenv("TEST_GOOGLE_CLIENT_SECRET", "google-secret")
+
+	tests := []struct {
+		name    string
+		content string
+		// 読み込まれるプロバイダーの名前
+		want []string
+		// エラーに含まれる文言（全て含むこと）
+		wantErr []string
+	}{
+		{
+			name: "env expansion",
+			content: `
+providers:
+  - name: google
+    type: google
+    client_id: ${TEST_GOOGLE_CLIENT_ID}
+    client_secret: ${TEST_GOOGLE_CLIENT_SECRET}
+`,
+			want: []string{"google"},
+		},
+		{
+			name: "unset env is an empty value",
+			content: `
+providers:
+  - name: google
+    type: google
+    client_id: ${TEST_UNSET_CLIENT_ID}
+    client_secret: secret
+`,
+			wantErr: []string{"providers[0]", "client_id is required"},
+		},
+		{
+			name: "disabled entries are skipped without validation",
+			content: `
+providers:
+  - name: google
+    type: google
+    client_id: id
+    client_secret: secret
+  - name: broken
+    type: unknown
+    enabled: false
+`,
+			want: []string{"google"},
+		},
+		{
+			name: "duplicate name",
+			content: `
+providers:
+  - {name: google, type: google, client_id: id, client_secret: secret}
+  - {name: google, type: github, client_id: id, client_secret: secret}
+`,
+			wantErr: []string{`providers[1]: duplicate name "google"`},
+		},
+		{
+			name: "reserved name",
+			content: `
+providers:
+  - {name: logout, type: github, client_id: id, client_secret: secret}
+`,
+			wantErr: []string{`name "logout" cannot be used in a URL path`},
+		},
+		{
+			name: "name with path characters",
+			content: `
+providers:
+  - {name: "a/b", type: github, client_id: id, client_secret: secret}
+`,
+			wantErr: []string{`name "a/b" cannot be used in a URL path`},
+		},
+		{
+			name: "oidc without discovery or issuer",
+			content: `
+providers:
+  - name: school
+    type: oidc
+    client_id: id
+    client_secret: secret
+    auth_url: https://idp.example.com/auth
+    token_url: https://idp.example.com/token
+`,
+			wantErr: []string{"discovery_url or auth_url, token_url and issuer_url are required for oidc"},
+		},
+		{
+			name: "oidc with explicit endpoints",
+			content: `
+providers:
+  - name: school
+    type: oidc
+    client_id: id
+    client_secret: secret
+    auth_url: https://idp.example.com/auth
+    token_url: https://idp.example.com/token
+    issuer_url: https://idp.example.com
+`,
+			want: []string{"school"},
+		},
+		{
+			name: "every error is reported",
+			content: `
+providers:
+  - {name: "", type: oidc}
+  - {name: gitlab, type: github, client_id: id, client_secret: secret, base_url: https://gitlab.example.com}
+`,
+			wantErr: []string{
+				"providers[0]: name is required",
+				"client_id is required",
+				"client_secret is required",
+				"required for oidc",
+				"providers[1]: base_url is only supported for gitlab",
+			},
+		},
+		{
+			name: "unsupported type",
+			content: `
+providers:
+  - {name: twitter, type: twitter, client_id: id, client_secret: secret}
+`,
+			wantErr: []string{`unsupported type "twitter"`},
+		},
+		{
+			name: "all disabled",
+			content: `
+providers:
+  - {name: google, type: google, client_id: id, client_secret: secret, enabled: false}
+`,
+			wantErr: []string{"no auth providers are enabled"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configs, err := LoadProviderConfigs(writeProvidersFile(t, tt.content))
+			if len(tt.wantErr) > 0 {
+				if err == nil {
+					t.Fatalf("err = nil, want %q", tt.wantErr)
+				}
+				for _, want := range tt.wantErr {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("err = %q, want it to contain %q", err, want)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, c := range configs {
+				names = append(names, c.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("providers = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadProviderConfigsExpandsSecrets(t *testing.T) {
+	t.Setenv("TEST_GOOGLE_CLIENT_SECRET", "from-env")
+	configs, err := LoadProviderConfigs(writeProvidersFile(t, `
+providers:
+  - {name: google, type: google, client_id: id, client_secret: "${TEST_GOOGLE_CLIENT_SECRET}"}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if configs[0].ClientSecret != "from-env" {
+		t.Errorf("client_secret = %q, want the environment value", configs[0].ClientSecret)
+	}
+}
+
+// ディスカバリーの応答を返すOIDCのサーバー（documentにはサーバーのURLを渡す）
+func newDiscoveryServer(t *testing.T, document func(base string) map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(document("http://" + r.Host))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestUseProvidersOIDCDiscovery(t *testing.T) {
+	srv := newDiscoveryServer(t, func(base string) map[string]string {
+		return map[string]string{
+			"issuer":                 base,
+			"authorization_endpoint": base + "/authorize",
+			"token_endpoint":         base + "/token",
+			"userinfo_endpoint":      base + "/userinfo",
+		}
+	})
+	t.Cleanup(goth.ClearProviders)
+
+	configs := []ProviderConfig{{
+		Name:         "school",
+		Type:         ProviderTypeOIDC,
+		DisplayName:  "School",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		DiscoveryURL: srv.URL + "/.well-known/openid-configuration",
+	}}
+	if err := UseProviders(configs, "https://app.example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	// URLの名前（/api/v1/auth/school）でプロバイダーを取得できる
+	provider, err := goth.GetProvider("school")
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err := provider.BeginAuth("state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	authURL, err := session.GetAuthURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.Scheme + "://" + u.Host + u.Path; got != srv.URL+"/authorize" {
+		t.Errorf("auth endpoint = %s, want the discovered endpoint", got)
+	}
+	q := u.Query()
+	if q.Get("client_id") != "client" {
+		t.Errorf("client_id = %q", q.Get("client_id"))
+	}
+	if q.Get("redirect_uri") != "https://app.example.com/api/v1/auth/school/callback" {
+		t.Errorf("redirect_uri = %q", q.Get("redirect_uri"))
+	}
+	if q.Get("scope") != "openid email profile" {
+		t.Errorf("scope = %q, want the oidc defaults", q.Get("scope"))
+	}
+
+	infos := EnabledProviders()
+	if len(infos) != 1 || infos[0] != (ProviderInfo{Name: "school", Type: ProviderTypeOIDC, DisplayName: "School"}) {
+		t.Errorf("enabled providers = %+v", infos)
+	}
+}
+
+func TestUseProvidersOIDCDiscoveryErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		document func(base string) map[string]string
+	}{
+		{"discovery not found", "/missing", nil},
+		{
+			"no endpoints", "/.well-known/openid-configuration",
+			func(base string) map[string]string { return map[string]string{"issuer": base} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newDiscoveryServer(t, tt.document)
+			configs := []ProviderConfig{{
+				Name: "school", Type: ProviderTypeOIDC, ClientID: "client", ClientSecret: "secret",
+				DiscoveryURL: srv.URL + tt.path,
+			}}
+			if err := UseProviders(configs, "https://app.example.com"); err == nil {
+				t.Error("UseProviders() = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+type viewerKey struct{}
+
+// パーソナルアクセストークンのスコープ
+const (
+	ScopeRead        = "read"         // クエリの実行
+	ScopeWriteWorks  = "write:works"  // 作品・スキルの作成と更新
+	ScopeAdminEvents = "admin:events" // イベントと主催者の管理
+)
+
+// 付与可能なスコープの一覧
+var Scopes = []string{ScopeRead, ScopeWriteWorks, ScopeAdminEvents}
+
+// リクエストを行っている認証済みユーザー
+type Viewer struct {
+	UserID    string
+	SessionID string // ログイン中の端末セッションID
+
+	// パーソナルアクセストークンで認証された場合のみ設定される
+	TokenID string
+	scopes  []string
+
+	// 認証に使ったトークンの有効期限（ゼロ値は期限なし）
+	ExpiresAt time.Time
+}
+
+// パーソナルアクセストークンで認証したユーザー
+func NewTokenViewer(userID, tokenID string, scopes []string) *Viewer {
+	return &Viewer{UserID: userID, TokenID: tokenID, scopes: scopes}
+}
+
+// パーソナルアクセストークンによるリクエストか
+func (v *Viewer) IsToken() bool {
+	return v.TokenID != ""
+}
+
+// スコープを持っているか（ブラウザのログインセッションは全てのスコープを持つ）
+func (v *Viewer) HasScope(scope string) bool {
+	if !v.IsToken() {
+		return true
+	}
+	for _, s := range v.scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// コンテキストに認証済みユーザーを保存
+func WithViewer(ctx context.Context, viewer *Viewer) context.Context {
+	return context.WithValue(ctx, viewerKey{}, viewer)
+}
+
+// コンテキストから認証済みユーザーを取得する（未認証の場合はnil）
+func ViewerFromContext(ctx context.Context) *Viewer {
+	viewer, _ := ctx.Value(viewerKey{}).(*Viewer)
+	return viewer
+}
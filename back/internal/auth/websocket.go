@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+var ErrInvalidToken = errors.New("auth: invalid token")
+
+// WebSocketの接続開始（connection_init）で認証する
+// ペイロードにAuthorizationがあればそのトークンで認証し、無ければ接続時のCookieによる認証結果をそのまま使う
+func WebsocketInit(sessions SessionChecker, tokens TokenAuthenticator) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		header := payload.Authorization()
+		if header == "" {
+			return ctx, nil, nil
+		}
+		viewer := authenticateToken(ctx, bearerToken(header), sessions, tokens)
+		if viewer == nil {
+			return ctx, nil, ErrInvalidToken
+		}
+		return WithViewer(ctx, viewer), nil, nil
+	}
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/config"
+)
+
+// テスト用の署名鍵を生成して読み込む
+func initTestJWT(t *testing.T) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "test.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.JWTConfig{KeysDir: dir, AccessTokenLifetime: 15 * time.Minute, RefreshTokenLifetime: 24 * time.Hour}
+	if _, err := config.InitJWT(cfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 失効した端末セッションを持つSessionChecker
+type fakeSessionChecker map[string]bool
+
+func (f fakeSessionChecker) IsDeviceSessionActive(ctx context.Context, userID, sessionID string) (bool, error) {
+	return !f[sessionID], nil
+}
+
+// 登録したトークンだけを受け付けるTokenAuthenticator
+type fakeTokenAuthenticator map[string]*model.PersonalAccessToken
+
+func (f fakeTokenAuthenticator) Authenticate(ctx context.Context, token string) (*model.PersonalAccessToken, error) {
+	if pat, ok := f[token]; ok {
+		return pat, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+func (f fakeTokenAuthenticator) IsActive(ctx context.Context, userID, tokenID string) (bool, error) {
+	return true, nil
+}
+
+func TestWebsocketInit(t *testing.T) {
+	initTestJWT(t)
+	access, err := config.GenerateAccessToken("u1", "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := config.GenerateAccessToken("u1", "s2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refresh, err := config.GenerateRefreshToken("u1", "s1", "r1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiresAt := time.Now().Add(time.Hour)
+	sessions := fakeSessionChecker{"s2": true}
+	tokens := fakeTokenAuthenticator{
+		PersonalAccessTokenPrefix + "valid": {ID: "pat1", UserID: "u2", Scopes: []string{"read"}, ExpiresAt: &expiresAt},
+	}
+	// 接続時のCookieで認証済みのユーザー
+	cookieViewer := &Viewer{UserID: "cookie-user"}
+
+	tests := []struct {
+		name    string
+		payload transport.InitPayload
+		// 認証後のユーザーID（空の場合は未認証）
+		wantUser string
+		wantErr  bool
+	}{
+		{"no authorization keeps the cookie viewer", nil, "cookie-user", false},
+		{"access token", transport.InitPayload{"Authorization": "Bearer " + access}, "u1", false},
+		{"lower case key", transport.InitPayload{"authorization": "bearer " + access}, "u1", false},
+		{"personal access token", transport.InitPayload{"Authorization": "Bearer " + PersonalAccessTokenPrefix + "valid"}, "u2", false},
+		{"revoked session", transport.InitPayload{"Authorization": "Bearer " + revoked}, "", true},
+		{"refresh token", transport.InitPayload{"Authorization": "Bearer " + refresh}, "", true},
+		{"unknown personal access token", transport.InitPayload{"Authorization": "Bearer " + PersonalAccessTokenPrefix + "unknown"}, "", true},
+		{"not bearer", transport.InitPayload{"Authorization": "Basic " + access}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _, err := WebsocketInit(sessions, tokens)(WithViewer(context.Background(), cookieViewer), tt.payload)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Fatalf("err = %v, want ErrInvalidToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			viewer := ViewerFromContext(ctx)
+			if viewer == nil || viewer.UserID != tt.wantUser {
+				t.Fatalf("viewer = %+v, want user %q", viewer, tt.wantUser)
+			}
+		})
+	}
+}
+
+// トークンで認証した場合は有効期限を引き継ぎ、期限後は認証が無効になる
+func TestWebsocketInitKeepsExpiry(t *testing.T) {
+	expiresAt := time.Now().Add(-time.Second)
+	tokens := fakeTokenAuthenticator{
+		PersonalAccessTokenPrefix + "expired": {ID: "pat1", UserID: "u2", ExpiresAt: &expiresAt},
+	}
+	ctx, _, err := WebsocketInit(nil, tokens)(context.Background(), transport.InitPayload{"Authorization": "Bearer " + PersonalAccessTokenPrefix + "expired"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	viewer := ViewerFromContext(ctx)
+	if !viewer.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("expires at = %s, want %s", viewer.ExpiresAt, expiresAt)
+	}
+	if StillAuthenticated(ctx, viewer, nil, tokens) {
+		t.Error("StillAuthenticated() = true after expiry")
+	}
+}
@@ -1,33 +1,385 @@
 package config
 
 import (
-	"log"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// アプリケーションの設定（起動時にLoadで読み込み、各コンポーネントに渡す）
 type Config struct {
-	DBUser     string
-	DBPassword string
-	DBName     string
-	DBHost     string
-	DBPort     string
+	// 実行環境（Development, Productionなど）
+	Env string
+	// サーバーが待ち受けるアドレス
+	Addr string
+	// フロントエンドのURL（リダイレクト先・CORSの許可オリジン）
+	HostURL string
+	// OAuth認証中のセッションに署名する鍵
+	SessionSecret string
+
+	Server  ServerConfig
+	GraphQL GraphQLConfig
+	Log     LogConfig
+	Trace   TraceConfig
+	MySQL   MySQLConfig
+	Redis   RedisConfig
+	JWT     JWTConfig
+	Cookie  CookieConfig
+
+	// 認証プロバイダーの設定ファイル
+	AuthProvidersFile string
+	// レート制限の設定ファイル
+	RateLimitsFile string
+}
+
+type ServerConfig struct {
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	// 停止時に処理中のリクエストの完了を待つ時間
+	ShutdownTimeout time.Duration
+	// /readyzで依存サービスごとに応答を待つ時間
+	HealthCheckTimeout time.Duration
+	// X-Forwarded-For・X-Real-IPを信頼する接続元（nginxなどのプロキシ）
+	TrustedProxies []*net.IPNet
+	// Prometheusのメトリクスを公開するアドレス（空の場合は公開しない）
+	// 外部に公開するポートとは分け、内部ネットワークからのみ取得できるようにする
+	MetricsAddr string
+}
+
+// GraphQLのクエリに課す制限（0の場合は制限しない）
+type GraphQLConfig struct {
+	// フィールドのネストの深さの上限
+	MaxDepth int
+	// クエリの複雑度の上限（リストは件数倍で数える）
+	MaxComplexity int
+	// 一度に取得できる件数の上限
+	MaxPageSize int
+
+	// APQのクエリをRedisに保存する期間（最後に使われてから）
+	APQTTL time.Duration
+	// フロントエンドのビルド時に生成するクエリのマニフェスト（空の場合は使わない）
+	PersistedQueriesFile string
+	// マニフェストにないクエリを拒否する（開発環境以外では既定で有効）
+	PersistedQueriesOnly bool
+}
+
+type LogConfig struct {
+	Level slog.Level
+	// JSON形式で出力する（falseの場合はテキスト形式）
+	JSON bool
+}
+
+type TraceConfig struct {
+	// 送信先（none: 無効, stdout: 標準出力, otlp: OTLP/HTTP）
+	Exporter string
+	// OTLPの送信先URL（未設定の場合はOTEL_EXPORTER_OTLP_ENDPOINTなどの標準の環境変数に従う）
+	OTLPEndpoint string
+	// 記録するトレースの割合（0〜1）
+	SampleRatio float64
+	ServiceName string
 }
 
-func Load() *Config {
-	return &Config{
-		DBUser:     os.Getenv("MYSQL_USER"),
-		DBPassword: os.Getenv("MYSQL_PASSWORD"),
-		DBName:     os.Getenv("MYSQL_DATABASE"),
-		DBHost:     "mysql", // Dockerコンテナ名
-		DBPort:     "3306",
+type MySQLConfig struct {
+	User     string
+	Password string
+	Database string
+	Host     string
+	Port     string
+}
+
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+}
+
+type JWTConfig struct {
+	// 署名鍵（*.pem）を置くディレクトリ
+	KeysDir string
+	// 署名に使用する鍵のkid（未設定の場合は鍵が1つだけであること）
+	ActiveKID string
+	// トークンの有効期限（クッキーの有効期限と同じ値を使う）
+	AccessTokenLifetime  time.Duration
+	RefreshTokenLifetime time.Duration
+}
+
+// フロントエンドのオリジン（CORSのAccess-Control-Allow-Originに使う）
+func (c *Config) HostOrigin() string {
+	u, err := url.Parse(c.HostURL)
+	if err != nil {
+		return ""
 	}
+	return u.Scheme + "://" + u.Host
+}
+
+// 開発環境かどうか
+func (c *Config) IsDevelopment() bool {
+	return strings.EqualFold(c.Env, "Development")
 }
 
-// Redisの設定
-func RedisAddress() string {
-	address := os.Getenv("REDIS_PORT")
-	if address == "" {
-		log.Fatal("REDIS_PORT is not set")
+// 設定で指定されたファイル（レート制限・認証プロバイダーなど）を読み込む
+// Loadに渡すと、環境変数の誤りと併せてまとめて報告する
+type FileLoader func(cfg *Config) error
+
+// 環境変数ファイルと環境変数から設定を読み込み、filesで設定ファイルを読み込む
+// 未設定・不正な値はすべてまとめてエラーとして返す
+func Load(files ...FileLoader) (*Config, error) {
+	if err := LoadEnv(); err != nil {
+		return nil, err
+	}
+
+	l := &envLoader{}
+	cfg := &Config{
+		Env:           l.string("ENV", "Production"),
+		Addr:          ":" + l.port("PORT", "8080"),
+		HostURL:       l.url("HOST_URL"),
+		SessionSecret: l.required("SESSION_SECRET"),
+		Server: ServerConfig{
+			ReadTimeout:        l.duration("SERVER_READ_TIMEOUT", 15*time.Second),
+			WriteTimeout:       l.duration("SERVER_WRITE_TIMEOUT", 30*time.Second),
+			IdleTimeout:        l.duration("SERVER_IDLE_TIMEOUT", 60*time.Second),
+			ShutdownTimeout:    l.duration("SERVER_SHUTDOWN_TIMEOUT", 20*time.Second),
+			HealthCheckTimeout: l.duration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+			TrustedProxies:     l.cidrs("TRUSTED_PROXIES"),
+			MetricsAddr:        os.Getenv("METRICS_ADDR"),
+		},
+		GraphQL: GraphQLConfig{
+			MaxDepth:      l.int("GRAPHQL_MAX_DEPTH", 10),
+			MaxComplexity: l.int("GRAPHQL_MAX_COMPLEXITY", 1000),
+			MaxPageSize:   l.int("GRAPHQL_MAX_PAGE_SIZE", 50),
+
+			APQTTL:               l.duration("GRAPHQL_APQ_TTL", 7*24*time.Hour),
+			PersistedQueriesFile: os.Getenv("PERSISTED_QUERIES_FILE"),
+		},
+		MySQL: l.mysql(),
+		Redis: RedisConfig{
+			Addr:     l.hostPort("REDIS_ADDR", "redis:6379"),
+			Password: os.Getenv("REDIS_PASSWORD"),
+			DB:       l.int("REDIS_DB", 0),
+		},
+		JWT: JWTConfig{
+			KeysDir:   l.required("JWT_KEYS_DIR"),
+			ActiveKID: os.Getenv("JWT_ACTIVE_KID"),
+		},
+		AuthProvidersFile: l.string("AUTH_PROVIDERS_FILE", "auth_providers.yaml"),
+		RateLimitsFile:    l.string("RATE_LIMITS_FILE", "rate_limits.yaml"),
+	}
+	cfg.Cookie = l.cookie(cfg.IsDevelopment())
+	// JWTのexp・端末セッションの有効期限がクッキーとずれないよう、同じ設定から決める
+	cfg.JWT.AccessTokenLifetime = cfg.Cookie.AccessTokenMaxAge
+	cfg.JWT.RefreshTokenLifetime = cfg.Cookie.RefreshTokenMaxAge
+	cfg.Log = l.log(cfg.IsDevelopment())
+	cfg.Trace = l.trace()
+	// 本番環境ではマニフェストにないクエリを既定で拒否する
+	cfg.GraphQL.PersistedQueriesOnly = l.bool("GRAPHQL_PERSISTED_QUERIES_ONLY", !cfg.IsDevelopment())
+	if cfg.GraphQL.PersistedQueriesOnly && cfg.GraphQL.PersistedQueriesFile == "" {
+		l.errorf("GRAPHQL_PERSISTED_QUERIES_ONLY", "requires PERSISTED_QUERIES_FILE (enabled by default unless ENV=Development)")
+	}
+
+	for _, load := range files {
+		if err := load(cfg); err != nil {
+			l.errs = append(l.errs, err)
+		}
+	}
+
+	if len(l.errs) > 0 {
+		return nil, errors.Join(l.errs...)
+	}
+	return cfg, nil
+}
+
+// MySQLの接続設定だけを読み込む（cmd/adminなど、サーバーを起動しないコマンド用）
+func LoadMySQL() (*MySQLConfig, error) {
+	if err := LoadEnv(); err != nil {
+		return nil, err
+	}
+
+	l := &envLoader{}
+	cfg := l.mysql()
+	if len(l.errs) > 0 {
+		return nil, errors.Join(l.errs...)
+	}
+	return &cfg, nil
+}
+
+// 環境変数を読み込み、誤りを記録する
+type envLoader struct {
+	errs []error
+}
+
+func (l *envLoader) errorf(key string, format string, args ...any) {
+	l.errs = append(l.errs, fmt.Errorf("%s: "+format, append([]any{key}, args...)...))
+}
+
+func (l *envLoader) string(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
+
+func (l *envLoader) required(key string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		l.errorf(key, "is not set")
+	}
+	return v
+}
+
+func (l *envLoader) int(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		l.errorf(key, "%q is not a non-negative integer", v)
+		return def
+	}
+	return n
+}
+
+func (l *envLoader) bool(key string, def bool) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		l.errorf(key, "%q is not a boolean", v)
+		return def
+	}
+	return b
+}
+
+func (l *envLoader) duration(key string, def time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		l.errorf(key, "%q is not a positive duration", v)
+		return def
+	}
+	return d
+}
+
+// ログの設定（LOG_FORMATが未設定の場合、開発環境以外ではJSON形式にする）
+func (l *envLoader) mysql() MySQLConfig {
+	return MySQLConfig{
+		User:     l.required("MYSQL_USER"),
+		Password: l.required("MYSQL_PASSWORD"),
+		Database: l.required("MYSQL_DATABASE"),
+		Host:     l.string("MYSQL_HOST", "mysql"), // Dockerコンテナ名
+		Port:     l.port("MYSQL_PORT", "3306"),
+	}
+}
+
+func (l *envLoader) log(development bool) LogConfig {
+	cfg := LogConfig{Level: slog.LevelInfo, JSON: !development}
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		if err := cfg.Level.UnmarshalText([]byte(v)); err != nil {
+			l.errorf("LOG_LEVEL", "%q must be one of debug, info, warn, error", v)
+		}
+	}
+	switch v := strings.ToLower(os.Getenv("LOG_FORMAT")); v {
+	case "":
+	case "json":
+		cfg.JSON = true
+	case "text":
+		cfg.JSON = false
+	default:
+		l.errorf("LOG_FORMAT", "%q must be json or text", v)
+	}
+	return cfg
+}
+
+// トレースの設定
+func (l *envLoader) trace() TraceConfig {
+	cfg := TraceConfig{
+		Exporter:     strings.ToLower(l.string("TRACE_EXPORTER", "none")),
+		OTLPEndpoint: os.Getenv("TRACE_OTLP_ENDPOINT"),
+		SampleRatio:  1,
+		ServiceName:  l.string("OTEL_SERVICE_NAME", "hackmeet-back"),
+	}
+	switch cfg.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		l.errorf("TRACE_EXPORTER", "%q must be one of none, stdout, otlp", cfg.Exporter)
+	}
+	if v := os.Getenv("TRACE_SAMPLE_RATIO"); v != "" {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			l.errorf("TRACE_SAMPLE_RATIO", "%q must be a number between 0 and 1", v)
+		} else {
+			cfg.SampleRatio = ratio
+		}
+	}
+	return cfg
+}
+
+func (l *envLoader) port(key, def string) string {
+	v := l.string(key, def)
+	if n, err := strconv.Atoi(v); err != nil || n <= 0 || n > 65535 {
+		l.errorf(key, "%q is not a valid port", v)
+	}
+	return v
+}
+
+// カンマ区切りのCIDR（IPアドレスのみの場合はそのアドレスだけ）
+func (l *envLoader) cidrs(key string) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if !strings.Contains(v, "/") {
+			if ip := net.ParseIP(v); ip != nil && ip.To4() != nil {
+				v += "/32"
+			} else {
+				v += "/128"
+			}
+		}
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			l.errorf(key, "%q is not a valid CIDR", v)
+			continue
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
+
+func (l *envLoader) hostPort(key, def string) string {
+	v := l.string(key, def)
+	if _, _, err := net.SplitHostPort(v); err != nil {
+		l.errorf(key, "%q must be host:port", v)
+	}
+	return v
+}
+
+// 絶対URL（リダイレクト先のパスを連結するため末尾を/にそろえる）
+func (l *envLoader) url(key string) string {
+	v := l.required(key)
+	if v == "" {
+		return v
+	}
+	u, err := url.Parse(v)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		l.errorf(key, "%q is not an absolute URL", v)
+		return v
+	}
+	if !strings.HasSuffix(v, "/") {
+		v += "/"
 	}
-	return address
+	return v
 }
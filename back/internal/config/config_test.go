@@ -0,0 +1,174 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 起動に必要な環境変数を設定する（他の設定は既定値を使う）
+func setValidEnv(t *testing.T) {
+	t.Helper()
+	for key, value := range map[string]string{
+		"CONFIG_FILE":            "",
+		"ENV":                    "Development",
+		"HOST_URL":               "https://app.example.com",
+		"SESSION_SECRET":         "secret",
+		"MYSQL_USER":             "user",
+		"MYSQL_PASSWORD":         "password",
+		"MYSQL_DATABASE":         "hackmeet",
+		"JWT_KEYS_DIR":           "/keys",
+		"PERSISTED_QUERIES_FILE": "",
+	} {
+		t.Setenv(key, value)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	setValidEnv(t)
+	t.Setenv("COOKIE_ACCESS_TOKEN_MAX_AGE", "5m")
+	t.Setenv("COOKIE_REFRESH_TOKEN_MAX_AGE", "168h")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Addr != ":8080" || cfg.MySQL.Port != "3306" || cfg.GraphQL.MaxDepth != 10 {
+		t.Errorf("defaults were not applied: addr=%s mysql port=%s max depth=%d", cfg.Addr, cfg.MySQL.Port, cfg.GraphQL.MaxDepth)
+	}
+	// JWTの有効期限はクッキーの有効期限と同じ
+	if cfg.JWT.AccessTokenLifetime != 5*time.Minute || cfg.JWT.RefreshTokenLifetime != 168*time.Hour {
+		t.Errorf("jwt lifetimes = %s, %s, want the cookie max ages", cfg.JWT.AccessTokenLifetime, cfg.JWT.RefreshTokenLifetime)
+	}
+	if cfg.Cookie.Secure || cfg.GraphQL.PersistedQueriesOnly {
+		t.Error("development should not require secure cookies or persisted queries")
+	}
+}
+
+func TestLoadProductionDefaults(t *testing.T) {
+	setValidEnv(t)
+	t.Setenv("ENV", "Production")
+	t.Setenv("PERSISTED_QUERIES_FILE", "/app/persisted_queries.json")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.Cookie.Secure || !cfg.GraphQL.PersistedQueriesOnly || !cfg.Log.JSON {
+		t.Errorf("production defaults: secure=%v persisted only=%v json log=%v", cfg.Cookie.Secure, cfg.GraphQL.PersistedQueriesOnly, cfg.Log.JSON)
+	}
+}
+
+// 誤りのある全ての環境変数をまとめて報告する
+func TestLoadReportsEveryError(t *testing.T) {
+	setValidEnv(t)
+	bad := map[string]string{
+		"ENV":                            "Production", // PERSISTED_QUERIES_FILEが必要になる
+		"HOST_URL":                       "app.example.com",
+		"SESSION_SECRET":                 "",
+		"MYSQL_USER":                     "",
+		"JWT_KEYS_DIR":                   "",
+		"PORT":                           "http",
+		"MYSQL_PORT":                     "70000",
+		"REDIS_DB":                       "-1",
+		"SERVER_READ_TIMEOUT":            "soon",
+		"COOKIE_ACCESS_TOKEN_MAX_AGE":    "0s",
+		"COOKIE_SECURE":                  "maybe",
+		"COOKIE_SAMESITE":                "loose",
+		"GRAPHQL_MAX_DEPTH":              "deep",
+		"LOG_LEVEL":                      "verbose",
+		"TRACE_SAMPLE_RATIO":             "2",
+		"TRUSTED_PROXIES":                "10.0.0.0/33",
+		"GRAPHQL_PERSISTED_QUERIES_ONLY": "",
+	}
+	for key, value := range bad {
+		t.Setenv(key, value)
+	}
+
+	_, err := Load()
+	if err == nil {
+		t.Fatal("Load() = nil, want an error")
+	}
+	for _, key := range []string{
+		"HOST_URL", "SESSION_SECRET", "MYSQL_USER", "JWT_KEYS_DIR", "PORT", "MYSQL_PORT", "REDIS_DB",
+		"SERVER_READ_TIMEOUT", "COOKIE_ACCESS_TOKEN_MAX_AGE", "COOKIE_SECURE", "COOKIE_SAMESITE",
+		"GRAPHQL_MAX_DEPTH", "LOG_LEVEL", "TRACE_SAMPLE_RATIO", "TRUSTED_PROXIES", "GRAPHQL_PERSISTED_QUERIES_ONLY",
+	} {
+		if !strings.Contains(err.Error(), key+":") {
+			t.Errorf("error does not report %s:\n%v", key, err)
+		}
+	}
+	// 1つの変数につき1行で報告する
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 16 {
+		t.Errorf("want 16 joined errors, got:\n%v", err)
+	}
+}
+
+// 設定ファイルの読み込みの誤りも環境変数の誤りと併せて報告する
+func TestLoadFileLoaders(t *testing.T) {
+	setValidEnv(t)
+	t.Setenv("SESSION_SECRET", "")
+	t.Setenv("RATE_LIMITS_FILE", "/missing/rate_limits.yaml")
+
+	var loaded []string
+	errRateLimits := errors.New("RATE_LIMITS_FILE: no such file")
+	_, err := Load(
+		func(cfg *Config) error {
+			loaded = append(loaded, cfg.RateLimitsFile)
+			return errRateLimits
+		},
+		func(cfg *Config) error {
+			loaded = append(loaded, cfg.AuthProvidersFile)
+			return nil
+		},
+	)
+	if !errors.Is(err, errRateLimits) {
+		t.Errorf("err = %v, want the file loader error", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "SESSION_SECRET:") {
+		t.Errorf("err = %v, want the environment error as well", err)
+	}
+	if strings.Join(loaded, ",") != "/missing/rate_limits.yaml,auth_providers.yaml" {
+		t.Errorf("loaders received %v", loaded)
+	}
+}
+
+// MySQLの設定だけを読み込む場合は、他の環境変数が未設定でもよい
+func TestLoadMySQL(t *testing.T) {
+	for key, value := range map[string]string{
+		"CONFIG_FILE":    "",
+		"ENV":            "Production",
+		"HOST_URL":       "",
+		"SESSION_SECRET": "",
+		"JWT_KEYS_DIR":   "",
+		"MYSQL_USER":     "user",
+		"MYSQL_PASSWORD": "password",
+		"MYSQL_DATABASE": "hackmeet",
+		"MYSQL_HOST":     "db.internal",
+		"MYSQL_PORT":     "",
+	} {
+		t.Setenv(key, value)
+	}
+
+	cfg, err := LoadMySQL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MySQLConfig{User: "user", Password: "password", Database: "hackmeet", Host: "db.internal", Port: "3306"}
+	if *cfg != want {
+		t.Errorf("LoadMySQL() = %+v, want %+v", *cfg, want)
+	}
+
+	t.Setenv("MYSQL_USER", "")
+	t.Setenv("MYSQL_PORT", "0")
+	_, err = LoadMySQL()
+	for _, key := range []string{"MYSQL_USER:", "MYSQL_PORT:"} {
+		if err == nil || !strings.Contains(err.Error(), key) {
+			t.Errorf("err = %v, want it to report %s", err, key)
+		}
+	}
+	if err != nil && strings.Contains(err.Error(), "SESSION_SECRET") {
+		t.Errorf("err = %v, want only MySQL settings to be checked", err)
+	}
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gorilla/sessions"
+)
+
+// クッキーの共通設定（アクセストークン・リフレッシュトークン・認証セッションで共有する）
+type CookieConfig struct {
+	Secure   bool
+	SameSite http.SameSite
+	Domain   string
+	Path     string
+
+	AccessTokenMaxAge  time.Duration
+	RefreshTokenMaxAge time.Duration
+	// OAuth認証中の状態を保存するセッションの有効期限
+	SessionMaxAge time.Duration
+}
+
+// クッキーの設定を読み込む
+// COOKIE_SECUREが未設定の場合、開発環境以外ではSecureにする
+func (l *envLoader) cookie(development bool) CookieConfig {
+	cfg := CookieConfig{
+		Secure:             l.bool("COOKIE_SECURE", !development),
+		SameSite:           http.SameSiteLaxMode,
+		Domain:             os.Getenv("COOKIE_DOMAIN"),
+		Path:               l.string("COOKIE_PATH", "/"),
+		AccessTokenMaxAge:  l.duration("COOKIE_ACCESS_TOKEN_MAX_AGE", 15*time.Minute),
+		RefreshTokenMaxAge: l.duration("COOKIE_REFRESH_TOKEN_MAX_AGE", 30*24*time.Hour),
+		SessionMaxAge:      l.duration("SESSION_MAX_AGE", 30*24*time.Hour),
+	}
+	if v := os.Getenv("COOKIE_SAMESITE"); v != "" {
+		sameSite, err := parseSameSite(v)
+		if err != nil {
+			l.errorf("COOKIE_SAMESITE", "%v", err)
+		} else {
+			cfg.SameSite = sameSite
+		}
+	}
+
+	// ブラウザはSecureでないSameSite=Noneのクッキーを拒否する
+	if cfg.SameSite == http.SameSiteNoneMode && !cfg.Secure {
+		l.errorf("COOKIE_SAMESITE", "none requires COOKIE_SECURE=true")
+	}
+	return cfg
+}
+
+func parseSameSite(v string) (http.SameSite, error) {
+	switch strings.ToLower(v) {
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	}
+	return http.SameSiteDefaultMode, fmt.Errorf("%q must be one of lax, strict, none", v)
+}
+
+// 共通設定を適用したHttpOnlyのクッキーを作成する
+func (c *CookieConfig) Cookie(name, value string, maxAge time.Duration) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		HttpOnly: true,
+		Secure:   c.Secure,
+		SameSite: c.SameSite,
+		Domain:   c.Domain,
+		Path:     c.Path,
+		MaxAge:   int(maxAge.Seconds()),
+	}
+}
+
+// クッキーを削除するためのクッキーを作成する
+func (c *CookieConfig) Expired(name string) *http.Cookie {
+	cookie := c.Cookie(name, "", 0)
+	cookie.MaxAge = -1
+	return cookie
+}
+
+// gothicのセッションストアに設定するオプション
+func (c *CookieConfig) SessionOptions() *sessions.Options {
+	// OAuthのコールバックは外部サイトからの遷移のため、Strictではセッションが送られない
+	sameSite := c.SameSite
+	if sameSite == http.SameSiteStrictMode {
+		sameSite = http.SameSiteLaxMode
+	}
+	return &sessions.Options{
+		Path:     c.Path,
+		Domain:   c.Domain,
+		MaxAge:   int(c.SessionMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   c.Secure,
+		SameSite: sameSite,
+	}
+}
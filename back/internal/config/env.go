@@ -1,16 +1,27 @@
 package config
 
 import (
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/joho/godotenv"
 )
 
-// 環境変数を読み込む
+// 環境変数ファイル（CONFIG_FILE、未設定の場合は.env）を読み込む
+// 既に設定されている環境変数は上書きしない
 func LoadEnv() error {
-	err := godotenv.Load()
-	if err != nil {
-		log.Println("Warning: .env file not found, using system environment variables")
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		if err := godotenv.Load(); err != nil {
+			log.Println("Warning: .env file not found, using system environment variables")
+		}
+		return nil
+	}
+
+	// 明示的に指定されたファイルが読めない場合は起動しない
+	if err := godotenv.Load(path); err != nil {
+		return fmt.Errorf("CONFIG_FILE: %w", err)
 	}
 	return nil
 }
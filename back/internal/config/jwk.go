@@ -0,0 +1,189 @@
+package config
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 署名鍵として許可するRSA鍵の最小ビット長
+const minRSAKeyBits = 2048
+
+// 署名・検証に使用する鍵
+type jwtKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer    // 検証専用の鍵の場合はnil
+	public  crypto.PublicKey // 検証に使用する公開鍵
+}
+
+// JWTの署名鍵と検証鍵の集合
+// 鍵のローテーション中は、署名に使用する鍵は1つで、過去の鍵は検証のみに使用する
+type KeySet struct {
+	signing *jwtKey
+	keys    map[string]*jwtKey
+}
+
+// ディレクトリ内の「<kid>.pem」ファイルから鍵を読み込む
+// 秘密鍵（RSA / Ed25519）は署名と検証に、公開鍵は検証のみに使用する
+// activeKidが空の場合、秘密鍵が1つだけであればそれを署名鍵とする
+// 鍵の生成例: openssl genpkey -algorithm ed25519 -out keys/<kid>.pem
+func LoadKeySet(dir string, activeKid string) (*KeySet, error) {
+	if dir == "" {
+		return nil, errors.New("JWT_KEYS_DIR is not set")
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jwt keys: %w", err)
+	}
+	sort.Strings(paths)
+
+	ks := &KeySet{keys: make(map[string]*jwtKey)}
+	var privateKids []string
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jwt key %s: %w", kid, err)
+		}
+		key, err := parseJWTKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwt key %s: %w", kid, err)
+		}
+		ks.keys[kid] = key
+		if key.private != nil {
+			privateKids = append(privateKids, kid)
+		}
+	}
+
+	if len(ks.keys) == 0 {
+		return nil, fmt.Errorf("no jwt keys found in %s", dir)
+	}
+
+	if activeKid == "" {
+		if len(privateKids) != 1 {
+			return nil, fmt.Errorf("JWT_ACTIVE_KID must be set when %d private keys are present", len(privateKids))
+		}
+		activeKid = privateKids[0]
+	}
+
+	signing, ok := ks.keys[activeKid]
+	if !ok {
+		return nil, fmt.Errorf("active jwt key %q not found", activeKid)
+	}
+	if signing.private == nil {
+		return nil, fmt.Errorf("active jwt key %q has no private key", activeKid)
+	}
+	ks.signing = signing
+
+	return ks, nil
+}
+
+// PEMから鍵を読み込む
+func parseJWTKey(kid string, data []byte) (*jwtKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &jwtKey{kid: kid}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.private, key.public = k, &k.PublicKey
+	case ed25519.PrivateKey:
+		key.private, key.public = k, k.Public()
+	case *rsa.PublicKey, ed25519.PublicKey:
+		key.public = k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+
+	switch pub := key.public.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("rsa key must be at least %d bits", minRSAKeyBits)
+		}
+		key.method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.method = jwt.SigningMethodEdDSA
+	}
+
+	return key, nil
+}
+
+// 公開鍵をJWK形式で表現したもの
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// /.well-known/jwks.json のレスポンス
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// 検証に使用する全ての公開鍵をJWKSとして返す
+func (ks *KeySet) JWKS() JWKS {
+	kids := make([]string, 0, len(ks.keys))
+	for kid := range ks.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	jwks := JWKS{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		key := ks.keys[kid]
+		jwk := JWK{Kid: kid, Use: "sig", Alg: key.method.Alg()}
+		switch pub := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks
+}
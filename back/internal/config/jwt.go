@@ -1,80 +1,153 @@
 package config
 
 import (
+	"errors"
 	"fmt"
-	"os"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtSecretKey = []byte(os.Getenv("JWT_SECRET"))
+// トークンの発行者
+const tokenIssuer = "nfc-auth"
+
+// 署名・検証に使用する鍵（起動時にInitJWTで設定する）
+var jwtKeys *KeySet
+
+// トークンの有効期限（起動時にInitJWTで設定する）
+var (
+	accessTokenLifetime  time.Duration
+	refreshTokenLifetime time.Duration
+)
+
+// 設定で指定された鍵を読み込み、トークンの署名・検証に使用する
+// 鍵が読み込めない場合はエラーを返すため、起動時に呼び出して失敗したら終了すること
+func InitJWT(cfg JWTConfig) (*KeySet, error) {
+	if cfg.AccessTokenLifetime <= 0 || cfg.RefreshTokenLifetime <= 0 {
+		return nil, errors.New("token lifetimes must be positive")
+	}
+	ks, err := LoadKeySet(cfg.KeysDir, cfg.ActiveKID)
+	if err != nil {
+		return nil, err
+	}
+	jwtKeys = ks
+	accessTokenLifetime = cfg.AccessTokenLifetime
+	refreshTokenLifetime = cfg.RefreshTokenLifetime
+	return ks, nil
+}
+
+// トークンの種類（typクレーム）
+// アクセストークンとリフレッシュトークンは同じ鍵で署名するため、取り違えて受け付けないよう区別する
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// 種類が異なるトークン
+var ErrUnexpectedTokenType = errors.New("unexpected token type")
 
 // カスタムクレーム構造体
 type CustomClaims struct {
-	jwt.StandardClaims
-	Id string `json:"id"` // ユーザーID
+	jwt.RegisteredClaims
+	Id        string `json:"id"`            // ユーザーID
+	SessionID string `json:"sid,omitempty"` // 端末セッションID
+	Type      string `json:"typ"`           // トークンの種類（access / refresh）
 }
 
 // アクセストークンを生成する関数
-func GenerateAccessToken(userID string) (string, error) {
+func GenerateAccessToken(userID string, sessionID string) (string, error) {
 	// トークンのペイロード
 	claims := &CustomClaims{
-		StandardClaims: jwt.StandardClaims{
-			Issuer:    "nfc-auth",                              // 発行者
-			IssuedAt:  time.Now().Unix(),                       // 発行時間
-			ExpiresAt: time.Now().Add(15 * time.Minute).Unix(), // 有効期限（15分）
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,                                             // 発行者
+			IssuedAt:  jwt.NewNumericDate(time.Now()),                          // 発行時間
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(accessTokenLifetime)), // 有効期限
 		},
-		Id: userID,
-	}
-
-	// JWTトークンを生成
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	// トークンを署名して文字列を生成
-	signedToken, err := token.SignedString(jwtSecretKey)
-	if err != nil {
-		return "", err
+		Id:        userID,
+		SessionID: sessionID,
+		Type:      TokenTypeAccess,
 	}
 
-	return signedToken, nil
+	return signToken(claims)
 }
 
 // リフレッシュトークンの生成関数
-func GenerateRefreshToken(userID string) (string, error) {
+// tokenIDはローテーションのたびに変わり、再利用の検知に使用する
+func GenerateRefreshToken(userID string, sessionID string, tokenID string) (string, error) {
 	// リフレッシュトークンのペイロード
 	claims := &CustomClaims{
-		StandardClaims: jwt.StandardClaims{
-			Issuer:    "nfc-auth",                                 // 発行者
-			IssuedAt:  time.Now().Unix(),                          // 発行時間
-			ExpiresAt: time.Now().Add(30 * 24 * time.Hour).Unix(), // 有効期限（30日）
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,                                              // 発行者
+			IssuedAt:  jwt.NewNumericDate(time.Now()),                           // 発行時間
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(refreshTokenLifetime)), // 有効期限
+			ID:        tokenID,
 		},
-		Id: userID,
+		Id:        userID,
+		SessionID: sessionID,
+		Type:      TokenTypeRefresh,
+	}
+
+	return signToken(claims)
+}
+
+// 署名鍵でトークンに署名し、kidヘッダーを付与する
+func signToken(claims jwt.Claims) (string, error) {
+	if jwtKeys == nil {
+		return "", errors.New("jwt keys are not initialized")
 	}
+	key := jwtKeys.signing
 
-	// JWTトークンを生成
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+
+	return token.SignedString(key.private)
+}
+
+// アクセストークンを解析する（リフレッシュトークンはエラー）
+func ParseAccessToken(tokenString string) (*CustomClaims, error) {
+	return parseTokenOfType(tokenString, TokenTypeAccess)
+}
+
+// リフレッシュトークンを解析する（アクセストークンはエラー）
+func ParseRefreshToken(tokenString string) (*CustomClaims, error) {
+	return parseTokenOfType(tokenString, TokenTypeRefresh)
+}
 
-	// トークンを署名して文字列を生成
-	signedToken, err := token.SignedString(jwtSecretKey)
+func parseTokenOfType(tokenString string, typ string) (*CustomClaims, error) {
+	claims, err := ParseToken(tokenString)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-
-	return signedToken, nil
+	if claims.Type != typ {
+		return nil, ErrUnexpectedTokenType
+	}
+	return claims, nil
 }
 
 // JWTトークンを解析してクレームを取得する関数
+// 種類を問わないため、認証にはParseAccessToken・ParseRefreshTokenを使うこと
 func ParseToken(tokenString string) (*CustomClaims, error) {
+	if jwtKeys == nil {
+		return nil, errors.New("jwt keys are not initialized")
+	}
+
 	// JWTトークンの解析
 	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// 署名の検証に使用する秘密鍵を返す
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		// kidに対応する検証鍵を返す
+		kid, _ := token.Header["kid"].(string)
+		key, ok := jwtKeys.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id: %q", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return jwtSecretKey, nil
-	})
-
+		return key.public, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(tokenIssuer),
+		jwt.WithExpirationRequired(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,184 @@
+package csrf
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/noonyuu/nfc/back/internal/config"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// ダブルサブミット用のトークンを保存するクッキー（フロントエンドから読めるようHttpOnlyにしない）
+	CookieName = "csrf_token"
+	// クッキーと同じトークンを送るリクエストヘッダー
+	HeaderName = "X-CSRF-Token"
+
+	// 検証に失敗した場合のエラーコード
+	CodeVerificationFailed = "CSRF_VERIFICATION_FAILED"
+
+	cookieMaxAge = 30 * 24 * time.Hour // 30日
+)
+
+var (
+	ErrOriginMismatch = errors.New("csrf: origin not allowed")
+	ErrTokenMismatch  = errors.New("csrf: token missing or mismatched")
+)
+
+// クッキーで認証されるリクエストのCSRF対策（オリジンの検証とダブルサブミットトークン）
+type Protector struct {
+	cookies        *config.CookieConfig
+	allowedOrigins map[string]bool
+}
+
+// allowedOriginsはフロントエンドのURL（パスは無視する）
+func New(cookies *config.CookieConfig, allowedOrigins ...string) *Protector {
+	p := &Protector{cookies: cookies, allowedOrigins: make(map[string]bool)}
+	for _, o := range allowedOrigins {
+		if origin := originOf(o); origin != "" {
+			p.allowedOrigins[origin] = true
+		}
+	}
+	return p
+}
+
+// 状態を変更するメソッドのリクエストを検証する
+// Authorizationヘッダーで認証するリクエストや、クッキーを持たないリクエストはトークンを検証しない
+func (p *Protector) Verify(r *http.Request) error {
+	if isSafeMethod(r.Method) {
+		return nil
+	}
+
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = originOf(r.Referer())
+	}
+	if origin != "" && !p.originAllowed(origin, r.Host) {
+		return ErrOriginMismatch
+	}
+
+	if r.Header.Get("Authorization") != "" || len(r.Cookies()) == 0 {
+		return nil
+	}
+
+	cookie, err := r.Cookie(CookieName)
+	if err != nil || cookie.Value == "" {
+		return ErrTokenMismatch
+	}
+	header := r.Header.Get(HeaderName)
+	if subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(header)) != 1 {
+		return ErrTokenMismatch
+	}
+	return nil
+}
+
+// トークンを発行する（有効なトークンのクッキーがあればそれを返す）
+func (p *Protector) Issue(w http.ResponseWriter, r *http.Request) (string, error) {
+	if cookie, err := r.Cookie(CookieName); err == nil && len(cookie.Value) == base64.RawURLEncoding.EncodedLen(32) {
+		return cookie.Value, nil
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	cookie := p.cookies.Cookie(CookieName, token, cookieMaxAge)
+	cookie.HttpOnly = false
+	http.SetCookie(w, cookie)
+	return token, nil
+}
+
+// GET /api/v1/auth/csrf: フロントエンドがリクエストヘッダーに付けるトークンを返す
+func (p *Protector) TokenHandler(c *gin.Context) {
+	token, err := p.Issue(c.Writer, c.Request)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to issue CSRF token"})
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, gin.H{"csrf_token": token})
+}
+
+// ginのルート用のミドルウェア
+func (p *Protector) Gin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := p.Verify(c.Request); err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "CSRF verification failed",
+				"code":  CodeVerificationFailed,
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// GraphQLのエンドポイント用のミドルウェア（GETでのミューテーションを拒否するため、メソッドをcontextに保存する）
+func (p *Protector) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := p.Verify(r); err != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":[{"message":"CSRF verification failed","extensions":{"code":"` + CodeVerificationFailed + `"}}],"data":null}`))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), methodKey{}, r.Method)))
+	})
+}
+
+type methodKey struct{}
+
+// GETリクエスト（WebSocketの接続を含む）ではミューテーションを実行しない
+func RejectMutationsOverGET(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	method, _ := ctx.Value(methodKey{}).(string)
+	oc := graphql.GetOperationContext(ctx)
+	if isSafeMethod(method) && oc.Operation != nil && oc.Operation.Operation == ast.Mutation {
+		return apperror.Reject(ctx, apperror.New(CodeVerificationFailed, "GETリクエストではミューテーションを実行できません。"))
+	}
+	return next(ctx)
+}
+
+// WebSocketの接続元を確認する（gorilla/websocketのUpgrader.CheckOriginに使う）
+// WebSocketはクロスオリジンでもCookie付きで接続できるため、許可したオリジン以外からの接続を拒否する
+func (p *Protector) CheckOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	return origin == "" || p.originAllowed(origin, r.Host)
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+func (p *Protector) originAllowed(origin, host string) bool {
+	if p.allowedOrigins[origin] {
+		return true
+	}
+	// 同一オリジン（nginx経由ではHostヘッダーが引き継がれる）
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, host)
+}
+
+// URLのスキーム・ホスト部分（解析できない場合は空文字）
+func originOf(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
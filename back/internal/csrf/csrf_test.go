@@ -0,0 +1,83 @@
+package csrf
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	p := New(nil, "https://app.example.com/some/path")
+	const token = "token-value"
+
+	tests := []struct {
+		name    string
+		method  string
+		origin  string
+		referer string
+		auth    string
+		cookies []*http.Cookie
+		header  string
+		want    error
+	}{
+		{name: "safe method", method: http.MethodGet, origin: "https://evil.example", cookies: []*http.Cookie{{Name: "session", Value: "s"}}},
+		// オリジン
+		{name: "foreign origin", method: http.MethodPost, origin: "https://evil.example", want: ErrOriginMismatch},
+		{name: "foreign referer", method: http.MethodPost, referer: "https://evil.example/page", want: ErrOriginMismatch},
+		{name: "allowed origin ignores path", method: http.MethodPost, origin: "https://app.example.com"},
+		{name: "same origin by host", method: http.MethodPost, origin: "http://api.example.com"},
+		{name: "foreign origin with bearer", method: http.MethodPost, origin: "https://evil.example", auth: "Bearer x", want: ErrOriginMismatch},
+		// ダブルサブミット
+		{name: "no cookies", method: http.MethodPost},
+		{
+			name: "matching token", method: http.MethodPost, origin: "https://app.example.com",
+			cookies: []*http.Cookie{{Name: "session", Value: "s"}, {Name: CookieName, Value: token}}, header: token,
+		},
+		{
+			name: "mismatched token", method: http.MethodPost,
+			cookies: []*http.Cookie{{Name: "session", Value: "s"}, {Name: CookieName, Value: token}}, header: "other",
+			want: ErrTokenMismatch,
+		},
+		{
+			name: "missing header", method: http.MethodPost,
+			cookies: []*http.Cookie{{Name: "session", Value: "s"}, {Name: CookieName, Value: token}},
+			want:    ErrTokenMismatch,
+		},
+		{
+			name: "missing token cookie", method: http.MethodPost,
+			cookies: []*http.Cookie{{Name: "session", Value: "s"}}, header: token,
+			want: ErrTokenMismatch,
+		},
+		{
+			name: "empty token cookie and header", method: http.MethodPost,
+			cookies: []*http.Cookie{{Name: "session", Value: "s"}, {Name: CookieName, Value: ""}},
+			want:    ErrTokenMismatch,
+		},
+		// Authorizationヘッダーで認証する場合はトークンを検証しない
+		{name: "bearer bypass", method: http.MethodPost, auth: "Bearer x", cookies: []*http.Cookie{{Name: "session", Value: "s"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "http://api.example.com/api/query", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			if tt.referer != "" {
+				r.Header.Set("Referer", tt.referer)
+			}
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			if tt.header != "" {
+				r.Header.Set(HeaderName, tt.header)
+			}
+			for _, c := range tt.cookies {
+				r.AddCookie(c)
+			}
+			if err := p.Verify(r); !errors.Is(err, tt.want) {
+				t.Errorf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
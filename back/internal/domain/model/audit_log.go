@@ -0,0 +1,47 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 監査ログの操作（GraphQLのミューテーションは "mutation.<フィールド名>"）
+const (
+	AuditActionLogin             = "auth.login"
+	AuditActionLogout            = "auth.logout"
+	AuditActionRefresh           = "auth.refresh"
+	AuditActionRefreshTokenReuse = "auth.refresh_token_reuse"
+	AuditActionLinkProvider      = "auth.link_provider"
+
+	AuditActionMutationPrefix = "mutation."
+)
+
+// 監査ログの対象の種類（GraphQLのミューテーションは戻り値の型名）
+const (
+	AuditTargetUser          = "User"
+	AuditTargetDeviceSession = "Session"
+)
+
+// 監査ログ（追記のみで更新・削除はしない）
+type AuditLog struct {
+	ID         int64
+	ActorID    string // 未ログイン・不明の場合は空文字
+	Action     string
+	TargetType string
+	TargetID   string
+	// 変更された項目ごとの変更前後の値 {"項目": {"before": ..., "after": ...}}
+	Changes   json.RawMessage
+	IPAddress string
+	UserAgent string
+	CreatedAt time.Time
+}
+
+// 監査ログの絞り込み条件（空の項目は条件にしない）
+type AuditLogFilter struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time
+	To         *time.Time
+}
@@ -0,0 +1,125 @@
+package model
+
+// 項目の公開範囲（後ろほど狭く、広い公開範囲は狭い公開範囲の閲覧者を全て含む）
+type Visibility string
+
+const (
+	VisibilityPublic          Visibility = "public"           // 全員
+	VisibilityConnections     Visibility = "connections"      // 作品の共同制作者と共通イベントの運営者
+	VisibilityEventOrganizers Visibility = "event_organizers" // 参加イベントの運営者
+	VisibilitySelf            Visibility = "self"             // 本人のみ
+)
+
+var visibilityRanks = map[Visibility]int{
+	VisibilityPublic:          0,
+	VisibilityConnections:     1,
+	VisibilityEventOrganizers: 2,
+	VisibilitySelf:            3,
+}
+
+// 定義済みの公開範囲か
+func (v Visibility) IsValid() bool {
+	_, ok := visibilityRanks[v]
+	return ok
+}
+
+// 閲覧者との関係（後ろほど近く、近い関係は遠い関係に公開された項目を全て閲覧できる）
+type Relationship int
+
+const (
+	RelationshipNone           Relationship = iota // 関係なし・未ログイン
+	RelationshipConnection                         // 作品の共同制作者
+	RelationshipEventOrganizer                     // 参加イベントの運営者
+	RelationshipSelf                               // 本人（管理者を含む）
+)
+
+// relationshipの閲覧者に公開されているか
+func (v Visibility) VisibleTo(relationship Relationship) bool {
+	rank, ok := visibilityRanks[v]
+	if !ok {
+		return relationship == RelationshipSelf
+	}
+	return int(relationship) >= rank
+}
+
+// 公開範囲を設定できる項目
+type PrivacyField string
+
+const (
+	PrivacyFieldEmail          PrivacyField = "email"
+	PrivacyFieldName           PrivacyField = "name"
+	PrivacyFieldAvatarURL      PrivacyField = "avatar_url"
+	PrivacyFieldGraduationYear PrivacyField = "graduation_year"
+	PrivacyFieldAffiliation    PrivacyField = "affiliation"
+	PrivacyFieldBio            PrivacyField = "bio"
+)
+
+// プロフィールごとの公開設定
+type PrivacySettings struct {
+	ProfileID string
+
+	// 公開プロフィールでの公開範囲
+	Email          Visibility
+	Name           Visibility
+	AvatarURL      Visibility
+	GraduationYear Visibility
+	Affiliation    Visibility
+	Bio            Visibility
+
+	// NFCカードに表示するか（カードを読み取った人には公開範囲に関わらず表示される）
+	CardAvatarURL      bool
+	CardGraduationYear bool
+	CardAffiliation    bool
+	CardBio            bool
+}
+
+// 設定が無いプロフィールの公開設定（メールアドレスは本人のみ、氏名はつながりのあるユーザーのみ）
+func DefaultPrivacySettings(profileID string) *PrivacySettings {
+	return &PrivacySettings{
+		ProfileID:          profileID,
+		Email:              VisibilitySelf,
+		Name:               VisibilityConnections,
+		AvatarURL:          VisibilityPublic,
+		GraduationYear:     VisibilityPublic,
+		Affiliation:        VisibilityPublic,
+		Bio:                VisibilityPublic,
+		CardAvatarURL:      true,
+		CardGraduationYear: true,
+		CardAffiliation:    true,
+		CardBio:            true,
+	}
+}
+
+// 項目の公開範囲
+func (s *PrivacySettings) VisibilityOf(field PrivacyField) Visibility {
+	switch field {
+	case PrivacyFieldEmail:
+		return s.Email
+	case PrivacyFieldName:
+		return s.Name
+	case PrivacyFieldAvatarURL:
+		return s.AvatarURL
+	case PrivacyFieldGraduationYear:
+		return s.GraduationYear
+	case PrivacyFieldAffiliation:
+		return s.Affiliation
+	case PrivacyFieldBio:
+		return s.Bio
+	}
+	return VisibilitySelf
+}
+
+// 項目をNFCカードに表示するか（カードに載らない項目はokがfalse）
+func (s *PrivacySettings) ShowOnCard(field PrivacyField) (show bool, ok bool) {
+	switch field {
+	case PrivacyFieldAvatarURL:
+		return s.CardAvatarURL, true
+	case PrivacyFieldGraduationYear:
+		return s.CardGraduationYear, true
+	case PrivacyFieldAffiliation:
+		return s.CardAffiliation, true
+	case PrivacyFieldBio:
+		return s.CardBio, true
+	}
+	return false, false
+}
@@ -0,0 +1,26 @@
+package model
+
+import "testing"
+
+func TestVisibleTo(t *testing.T) {
+	relationships := []Relationship{RelationshipNone, RelationshipConnection, RelationshipEventOrganizer, RelationshipSelf}
+	tests := []struct {
+		visibility Visibility
+		// relationshipsの順に閲覧できるか
+		want [4]bool
+	}{
+		{VisibilityPublic, [4]bool{true, true, true, true}},
+		{VisibilityConnections, [4]bool{false, true, true, true}},
+		{VisibilityEventOrganizers, [4]bool{false, false, true, true}},
+		{VisibilitySelf, [4]bool{false, false, false, true}},
+		{Visibility("unknown"), [4]bool{false, false, false, true}},
+	}
+
+	for _, tt := range tests {
+		for i, relationship := range relationships {
+			if got := tt.visibility.VisibleTo(relationship); got != tt.want[i] {
+				t.Errorf("%s.VisibleTo(%d) = %v, want %v", tt.visibility, relationship, got, tt.want[i])
+			}
+		}
+	}
+}
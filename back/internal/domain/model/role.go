@@ -0,0 +1,33 @@
+package model
+
+// ユーザーの権限（後ろほど強く、上位の権限は下位の権限を全て含む）
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleOrganizer Role = "organizer" // イベントの作成
+	RoleModerator Role = "moderator" // 作品・イベント・スキルの管理
+	RoleAdmin     Role = "admin"     // ユーザーの権限を含む全ての管理
+)
+
+var roleRanks = map[Role]int{
+	RoleUser:      0,
+	RoleOrganizer: 1,
+	RoleModerator: 2,
+	RoleAdmin:     3,
+}
+
+// 定義済みの権限か
+func (r Role) IsValid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// requiredの権限を含んでいるか
+func (r Role) Includes(required Role) bool {
+	rank, ok := roleRanks[r]
+	if !ok {
+		return false
+	}
+	return rank >= roleRanks[required]
+}
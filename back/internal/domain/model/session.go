@@ -7,3 +7,19 @@ type Session struct {
 	Value     interface{}   `json:"value" redis:"value"`
 	ExpiresAt time.Duration `json:"expires_at" redis:"expires_at"`
 }
+
+// 端末ごとのログインセッション
+// リフレッシュトークンはローテーションされ、TokenIDには現在有効なトークンのIDを保持する
+// PreviousTokenID・RotatedAtは直前のトークンで、同時のリフレッシュを猶予期間内は再利用とみなさないために使う
+type DeviceSession struct {
+	ID              string    `json:"id"`
+	UserID          string    `json:"user_id"`
+	TokenID         string    `json:"token_id"`
+	PreviousTokenID string    `json:"previous_token_id,omitempty"`
+	RotatedAt       time.Time `json:"rotated_at,omitempty"`
+	DeviceName      string    `json:"device_name"`
+	UserAgent       string    `json:"user_agent"`
+	IPAddress       string    `json:"ip_address"`
+	CreatedAt       time.Time `json:"created_at"`
+	LastUsedAt      time.Time `json:"last_used_at"`
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+type AuditLogRepository interface {
+	Append(ctx context.Context, log *model.AuditLog) error
+	// 新しい順に取得（beforeIDが0以外の場合はそれより古いもの）
+	List(ctx context.Context, filter model.AuditLogFilter, beforeID int64, limit int) ([]*model.AuditLog, error)
+}
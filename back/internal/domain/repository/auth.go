@@ -11,5 +11,10 @@ import (
 type UserRepository interface {
 	Create(ctx context.Context, user *model.User, userP goth.User) error
 	GetByUserID(ctx context.Context, userID string) (*model.User, error)
-	FindByEmail(ctx context.Context, email string, userID string, provider string) (*model.User, error)
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
+	FindByProvider(ctx context.Context, provider string, providerID string) (*model.User, error)
+	ListProviders(ctx context.Context, userID string) ([]*model.Provider, error)
+	LinkProvider(ctx context.Context, userID string, provider string, providerID string) error
+	// 他のプロバイダーが残っている場合のみ削除し、削除できたかを返す
+	UnlinkProvider(ctx context.Context, userID string, provider string) (bool, error)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+type PermissionRepository interface {
+	WorkExists(ctx context.Context, workID string) (bool, error)
+	IsWorkMember(ctx context.Context, workID string, userID string) (bool, error)
+	FindWorkIDByWorkProfileID(ctx context.Context, workProfileID string) (string, error)
+	FindWorkIDByWorkSkillID(ctx context.Context, workSkillID int32) (string, error)
+	FindProfileIDByProfileSkillID(ctx context.Context, profileSkillID int32) (string, error)
+	// 作成者がいない（created_byがNULL）場合はcreatorが空文字、イベントが存在しない場合はfoundがfalse
+	FindEventCreator(ctx context.Context, eventID string) (creator string, found bool, err error)
+	IsEventOrganizer(ctx context.Context, eventID string, userID string) (bool, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+)
+
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *model.PersonalAccessToken) error
+	// ハッシュ値からトークンを取得（存在しない場合はnil）
+	FindByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error)
+	ListByUserID(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error)
+	// ユーザー自身のトークンを削除し、削除できたかを返す
+	Delete(ctx context.Context, userID string, id string) (bool, error)
+	UpdateLastUsedAt(ctx context.Context, id string, lastUsedAt time.Time) error
+}
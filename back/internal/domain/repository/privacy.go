@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+type PrivacyRepository interface {
+	// プロフィールの公開設定を取得（設定が無い場合はnil）
+	GetSettings(ctx context.Context, profileID string) (*model.PrivacySettings, error)
+	SaveSettings(ctx context.Context, settings *model.PrivacySettings) error
+	// 同じ作品のメンバーとして登録されているか
+	AreWorkCollaborators(ctx context.Context, userID string, otherUserID string) (bool, error)
+	// organizerIDのユーザーが、userIDのユーザーの作品が登録されたイベントの作成者・運営者か
+	IsOrganizerOfUserEvent(ctx context.Context, organizerID string, userID string) (bool, error)
+}
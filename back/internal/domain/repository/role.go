@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+// 最後の管理者を降格しようとした
+var ErrLastAdmin = errors.New("cannot demote the last admin")
+
+type RoleRepository interface {
+	// ユーザーの権限を取得（ユーザーが存在しない場合は空文字）
+	GetRole(ctx context.Context, userID string) (model.Role, error)
+	// 権限を更新し、対象のユーザーが存在したかを返す
+	// 管理者が居なくなる場合は更新せずErrLastAdminを返す（同時に降格しても管理者は残る）
+	SetRole(ctx context.Context, userID string, role model.Role) (bool, error)
+	CountByRole(ctx context.Context, role model.Role) (int, error)
+}
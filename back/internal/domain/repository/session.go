@@ -1,12 +1,21 @@
 package repository
 
 import (
-	"github.com/noonyuu/nfc/back/internal/domain/model"
 	"context"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
 )
 
 type SessionRepository interface {
 	Save(ctx context.Context, session *model.Session) error
-	Get(ctx context.Context, sessionID string) (*model.Session , error)
+	Get(ctx context.Context, sessionID string) (*model.Session, error)
 	Delete(ctx context.Context, sessionID string) error
+
+	SaveDeviceSession(ctx context.Context, session *model.DeviceSession, expiration time.Duration) error
+	// 保存されているトークンIDがexpectedTokenIDの場合のみ更新し、更新したかを返す
+	CompareAndSwapDeviceSession(ctx context.Context, session *model.DeviceSession, expectedTokenID string, expiration time.Duration) (bool, error)
+	GetDeviceSession(ctx context.Context, sessionID string) (*model.DeviceSession, error)
+	ListDeviceSessions(ctx context.Context, userID string) ([]*model.DeviceSession, error)
+	DeleteDeviceSession(ctx context.Context, userID string, sessionID string) error
 }
@@ -0,0 +1,106 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// 依存サービスへの疎通確認（応答できない場合はエラーを返す）
+type Check func(ctx context.Context) error
+
+type dependency struct {
+	name  string
+	check Check
+}
+
+// /healthz・/readyzの応答
+type Report struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyStatus `json:"dependencies,omitempty"`
+}
+
+type DependencyStatus struct {
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// 依存サービスを登録し、稼働状態を返すハンドラー
+type Probe struct {
+	timeout      time.Duration
+	dependencies []dependency
+}
+
+// timeoutは依存サービスごとの確認の制限時間
+func New(timeout time.Duration) *Probe {
+	return &Probe{timeout: timeout}
+}
+
+// 準備完了の判定に使う依存サービスを追加する
+func (p *Probe) Add(name string, check Check) {
+	p.dependencies = append(p.dependencies, dependency{name: name, check: check})
+}
+
+// GET /healthz: プロセスが応答できるか（依存サービスは確認しない）
+func (p *Probe) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, Report{Status: StatusOK})
+}
+
+// GET /readyz: すべての依存サービスに接続できるか
+func (p *Probe) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := p.Check(r.Context())
+	code := http.StatusOK
+	if report.Status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	writeReport(w, code, report)
+}
+
+// 依存サービスを並行して確認する
+func (p *Probe) Check(ctx context.Context) Report {
+	report := Report{Status: StatusOK, Dependencies: make(map[string]DependencyStatus, len(p.dependencies))}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, d := range p.dependencies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, p.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := d.check(ctx)
+			status := DependencyStatus{Status: StatusOK, LatencyMs: time.Since(start).Milliseconds()}
+			if err != nil {
+				// 接続先の情報を含むため、エラーの詳細はログにのみ出力する
+				slog.WarnContext(ctx, "health check failed", "dependency", d.name, "error", err)
+				status.Status = StatusUnavailable
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Dependencies[d.name] = status
+			if err != nil {
+				report.Status = StatusUnavailable
+			}
+		}()
+	}
+	wg.Wait()
+	return report
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(report)
+}
@@ -3,19 +3,23 @@ package db
 import (
 	"fmt"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 	"github.com/noonyuu/nfc/back/internal/config"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
-func ConnectMysql(cfg *config.Config) (*sqlx.DB, error) {
+func ConnectMysql(cfg config.MySQLConfig) (*sqlx.DB, error) {
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true",
-		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName)
+		cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.Database)
 
-	db, err := sqlx.Open("mysql", dsn)
+	// SQLの実行ごとにスパンを作成する
+	sqlDB, err := otelsql.Open("mysql", dsn, otelsql.WithAttributes(semconv.DBSystemMySQL))
 	if err != nil {
 		return nil, err
 	}
+	db := sqlx.NewDb(sqlDB, "mysql")
 	if err := db.Ping(); err != nil {
 		return nil, err
 	}
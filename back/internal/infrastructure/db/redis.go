@@ -4,22 +4,24 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/noonyuu/nfc/back/internal/config"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
-func ConnectRedis() (*redis.Client, error) {
-	// Redisの接続情報を取得
-	address := "redis:6379"
-	password := ""
-	db := 0
-
+func ConnectRedis(cfg *config.Config) (*redis.Client, error) {
 	// Redisに接続
 	client := redis.NewClient(&redis.Options{
-		Addr:     address,
-		Password: password, // パスワードが設定されていない場合は空文字列
-		DB:       db,       // デフォルトDBを使用
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password, // パスワードが設定されていない場合は空文字列
+		DB:       cfg.Redis.DB,
 	})
 
+	// コマンドごとにスパンを作成する
+	if err := redisotel.InstrumentTracing(client); err != nil {
+		return nil, fmt.Errorf("failed to instrument Redis: %w", err)
+	}
+
 	// 接続確認
 	if err := client.Ping(context.Background()).Err(); err != nil {
 		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
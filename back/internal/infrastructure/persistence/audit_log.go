@@ -0,0 +1,141 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SQLクエリの定数
+const (
+	insertAuditLogSQL = `
+		INSERT INTO audit_logs (actor_id, action, target_type, target_id, changes, ip_address, user_agent, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	selectAuditLogsSQL = `
+		SELECT id, actor_id, action, target_type, target_id, changes, ip_address, user_agent, created_at
+		FROM audit_logs
+	`
+)
+
+type auditLogPersistence struct {
+	db *sqlx.DB
+}
+
+func NewAuditLogPersistence(db *sqlx.DB) repository.AuditLogRepository {
+	return &auditLogPersistence{db: db}
+}
+
+// 監査ログを追記
+func (p *auditLogPersistence) Append(ctx context.Context, log *model.AuditLog) error {
+	var changes interface{}
+	if len(log.Changes) > 0 {
+		changes = string(log.Changes)
+	}
+
+	result, err := p.db.ExecContext(ctx, insertAuditLogSQL,
+		nullString(log.ActorID),
+		log.Action,
+		nullString(log.TargetType),
+		nullString(log.TargetID),
+		changes,
+		nullString(log.IPAddress),
+		nullString(log.UserAgent),
+		log.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit log: %w", err)
+	}
+	if id, err := result.LastInsertId(); err == nil {
+		log.ID = id
+	}
+	return nil
+}
+
+// 条件に一致する監査ログを新しい順に取得
+func (p *auditLogPersistence) List(ctx context.Context, filter model.AuditLogFilter, beforeID int64, limit int) ([]*model.AuditLog, error) {
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, arg interface{}) {
+		conditions = append(conditions, condition)
+		args = append(args, arg)
+	}
+
+	if filter.ActorID != "" {
+		addCondition("actor_id = ?", filter.ActorID)
+	}
+	if filter.Action != "" {
+		addCondition("action = ?", filter.Action)
+	}
+	if filter.TargetType != "" {
+		addCondition("target_type = ?", filter.TargetType)
+	}
+	if filter.TargetID != "" {
+		addCondition("target_id = ?", filter.TargetID)
+	}
+	if filter.From != nil {
+		addCondition("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("created_at < ?", *filter.To)
+	}
+	if beforeID > 0 {
+		addCondition("id < ?", beforeID)
+	}
+
+	query := selectAuditLogsSQL
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit logs: %w", err)
+	}
+	defer rows.Close()
+
+	var logs []*model.AuditLog
+	for rows.Next() {
+		var log model.AuditLog
+		var actorID, targetType, targetID, changes, ipAddress, userAgent sql.NullString
+		if err := rows.Scan(
+			&log.ID,
+			&actorID,
+			&log.Action,
+			&targetType,
+			&targetID,
+			&changes,
+			&ipAddress,
+			&userAgent,
+			&log.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan audit log: %w", err)
+		}
+		log.ActorID = actorID.String
+		log.TargetType = targetType.String
+		log.TargetID = targetID.String
+		if changes.Valid {
+			log.Changes = []byte(changes.String)
+		}
+		log.IPAddress = ipAddress.String
+		log.UserAgent = userAgent.String
+		logs = append(logs, &log)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate audit logs: %w", err)
+	}
+	return logs, nil
+}
+
+// 空文字はNULLとして保存する
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
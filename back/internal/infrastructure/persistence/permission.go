@@ -0,0 +1,98 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SQLクエリの定数
+const (
+	checkWorkExistsSQL            = "SELECT 1 FROM works WHERE id = ? LIMIT 1"
+	checkWorkMemberSQL            = "SELECT 1 FROM work_profiles WHERE work_id = ? AND profile_id = ? LIMIT 1"
+	selectWorkIDByWorkProfileSQL  = "SELECT work_id FROM work_profiles WHERE id = ?"
+	selectWorkIDByWorkSkillSQL    = "SELECT work_id FROM work_skills WHERE id = ?"
+	selectProfileIDByProfileSkill = "SELECT profile_id FROM profile_skills WHERE id = ?"
+	selectEventCreatorSQL         = "SELECT created_by FROM events WHERE id = ?"
+	checkEventOrganizerSQL        = "SELECT 1 FROM event_organizers WHERE event_id = ? AND user_id = ? LIMIT 1"
+)
+
+type permissionPersistence struct {
+	db *sqlx.DB
+}
+
+func NewPermissionPersistence(db *sqlx.DB) repository.PermissionRepository {
+	return &permissionPersistence{db: db}
+}
+
+// 作品の存在確認
+func (p *permissionPersistence) WorkExists(ctx context.Context, workID string) (bool, error) {
+	return p.exists(ctx, checkWorkExistsSQL, workID)
+}
+
+// 作品のメンバー（work_profiles）に含まれているか確認
+func (p *permissionPersistence) IsWorkMember(ctx context.Context, workID, userID string) (bool, error) {
+	return p.exists(ctx, checkWorkMemberSQL, workID, userID)
+}
+
+// work_profilesのIDから作品IDを取得（存在しない場合は空文字）
+func (p *permissionPersistence) FindWorkIDByWorkProfileID(ctx context.Context, workProfileID string) (string, error) {
+	return p.findString(ctx, selectWorkIDByWorkProfileSQL, workProfileID)
+}
+
+// work_skillsのIDから作品IDを取得（存在しない場合は空文字）
+func (p *permissionPersistence) FindWorkIDByWorkSkillID(ctx context.Context, workSkillID int32) (string, error) {
+	return p.findString(ctx, selectWorkIDByWorkSkillSQL, workSkillID)
+}
+
+// profile_skillsのIDからプロフィールIDを取得（存在しない場合は空文字）
+func (p *permissionPersistence) FindProfileIDByProfileSkillID(ctx context.Context, profileSkillID int32) (string, error) {
+	return p.findString(ctx, selectProfileIDByProfileSkill, profileSkillID)
+}
+
+// イベントの作成者を取得（作成者がいない場合は空文字、イベントが存在しない場合はfoundがfalse）
+func (p *permissionPersistence) FindEventCreator(ctx context.Context, eventID string) (string, bool, error) {
+	var creator sql.NullString
+	err := p.db.QueryRowContext(ctx, selectEventCreatorSQL, eventID).Scan(&creator)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to find owner: %w", err)
+	}
+	return creator.String, true, nil
+}
+
+// イベントの運営者（event_organizers）に含まれているか確認
+func (p *permissionPersistence) IsEventOrganizer(ctx context.Context, eventID, userID string) (bool, error) {
+	return p.exists(ctx, checkEventOrganizerSQL, eventID, userID)
+}
+
+func (p *permissionPersistence) exists(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	var exists bool
+	err := p.db.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check permission: %w", err)
+	}
+	return exists, nil
+}
+
+func (p *permissionPersistence) findString(ctx context.Context, query string, args ...interface{}) (string, error) {
+	var value sql.NullString
+	err := p.db.QueryRowContext(ctx, query, args...).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to find owner: %w", err)
+	}
+	return value.String, nil
+}
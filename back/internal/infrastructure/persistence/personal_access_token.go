@@ -0,0 +1,120 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SQLクエリの定数
+const (
+	insertPersonalAccessTokenSQL = "INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, expires_at, last_used_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	selectPersonalAccessTokenSQL = "SELECT id, user_id, name, token_hash, scopes, expires_at, last_used_at, created_at FROM personal_access_tokens"
+	deletePersonalAccessTokenSQL = "DELETE FROM personal_access_tokens WHERE id = ? AND user_id = ?"
+	updateTokenLastUsedAtSQL     = "UPDATE personal_access_tokens SET last_used_at = ? WHERE id = ?"
+)
+
+type personalAccessTokenPersistence struct {
+	db *sqlx.DB
+}
+
+func NewPersonalAccessTokenPersistence(db *sqlx.DB) repository.PersonalAccessTokenRepository {
+	return &personalAccessTokenPersistence{db: db}
+}
+
+// トークンを保存（平文のトークンは保存しない）
+func (p *personalAccessTokenPersistence) Create(ctx context.Context, token *model.PersonalAccessToken) error {
+	_, err := p.db.ExecContext(ctx, insertPersonalAccessTokenSQL,
+		token.ID, token.UserID, token.Name, token.TokenHash, strings.Join(token.Scopes, " "),
+		token.ExpiresAt, token.LastUsedAt, token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert personal access token: %w", err)
+	}
+	return nil
+}
+
+// ハッシュ値からトークンを取得
+func (p *personalAccessTokenPersistence) FindByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error) {
+	token, err := scanPersonalAccessToken(p.db.QueryRowContext(ctx, selectPersonalAccessTokenSQL+" WHERE token_hash = ?", tokenHash))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find personal access token: %w", err)
+	}
+	return token, nil
+}
+
+// ユーザーのトークン一覧
+func (p *personalAccessTokenPersistence) ListByUserID(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error) {
+	rows, err := p.db.QueryContext(ctx, selectPersonalAccessTokenSQL+" WHERE user_id = ? ORDER BY created_at DESC", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query personal access tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*model.PersonalAccessToken
+	for rows.Next() {
+		token, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan personal access token: %w", err)
+		}
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate personal access tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// トークンを削除
+func (p *personalAccessTokenPersistence) Delete(ctx context.Context, userID, id string) (bool, error) {
+	result, err := p.db.ExecContext(ctx, deletePersonalAccessTokenSQL, id, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete personal access token: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// 最終使用日時を更新
+func (p *personalAccessTokenPersistence) UpdateLastUsedAt(ctx context.Context, id string, lastUsedAt time.Time) error {
+	if _, err := p.db.ExecContext(ctx, updateTokenLastUsedAtSQL, lastUsedAt, id); err != nil {
+		return fmt.Errorf("failed to update last used at: %w", err)
+	}
+	return nil
+}
+
+// *sql.Row と *sql.Rows の共通インターフェース
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPersonalAccessToken(row rowScanner) (*model.PersonalAccessToken, error) {
+	token := &model.PersonalAccessToken{}
+	var scopes string
+	var expiresAt, lastUsedAt sql.NullTime
+	if err := row.Scan(&token.ID, &token.UserID, &token.Name, &token.TokenHash, &scopes, &expiresAt, &lastUsedAt, &token.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	token.Scopes = strings.Fields(scopes)
+	if expiresAt.Valid {
+		token.ExpiresAt = &expiresAt.Time
+	}
+	if lastUsedAt.Valid {
+		token.LastUsedAt = &lastUsedAt.Time
+	}
+	return token, nil
+}
@@ -0,0 +1,148 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SQLクエリの定数
+const (
+	selectPrivacySettingsSQL = `
+		SELECT profile_id, email_visibility, name_visibility, avatar_url_visibility,
+			graduation_year_visibility, affiliation_visibility, bio_visibility,
+			card_avatar_url, card_graduation_year, card_affiliation, card_bio
+		FROM profile_privacy_settings
+		WHERE profile_id = ?
+	`
+	upsertPrivacySettingsSQL = `
+		INSERT INTO profile_privacy_settings (
+			profile_id, email_visibility, name_visibility, avatar_url_visibility,
+			graduation_year_visibility, affiliation_visibility, bio_visibility,
+			card_avatar_url, card_graduation_year, card_affiliation, card_bio,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			email_visibility = VALUES(email_visibility),
+			name_visibility = VALUES(name_visibility),
+			avatar_url_visibility = VALUES(avatar_url_visibility),
+			graduation_year_visibility = VALUES(graduation_year_visibility),
+			affiliation_visibility = VALUES(affiliation_visibility),
+			bio_visibility = VALUES(bio_visibility),
+			card_avatar_url = VALUES(card_avatar_url),
+			card_graduation_year = VALUES(card_graduation_year),
+			card_affiliation = VALUES(card_affiliation),
+			card_bio = VALUES(card_bio),
+			updated_at = VALUES(updated_at)
+	`
+	checkWorkCollaboratorsSQL = `
+		SELECT 1
+		FROM work_profiles a
+		JOIN work_profiles b ON a.work_id = b.work_id
+		WHERE a.profile_id = ? AND b.profile_id = ?
+		LIMIT 1
+	`
+	checkOrganizerOfUserEventSQL = `
+		SELECT 1
+		FROM work_profiles wp
+		JOIN work_events we ON we.work_id = wp.work_id
+		JOIN events e ON e.id = we.event_id
+		LEFT JOIN event_organizers eo ON eo.event_id = e.id AND eo.user_id = ?
+		WHERE wp.profile_id = ? AND (e.created_by = ? OR eo.user_id IS NOT NULL)
+		LIMIT 1
+	`
+)
+
+type privacyPersistence struct {
+	db *sqlx.DB
+}
+
+func NewPrivacyPersistence(db *sqlx.DB) repository.PrivacyRepository {
+	return &privacyPersistence{db: db}
+}
+
+// プロフィールの公開設定を取得
+func (p *privacyPersistence) GetSettings(ctx context.Context, profileID string) (*model.PrivacySettings, error) {
+	var s model.PrivacySettings
+	var email, name, avatarURL, graduationYear, affiliation, bio string
+	err := p.db.QueryRowContext(ctx, selectPrivacySettingsSQL, profileID).Scan(
+		&s.ProfileID,
+		&email,
+		&name,
+		&avatarURL,
+		&graduationYear,
+		&affiliation,
+		&bio,
+		&s.CardAvatarURL,
+		&s.CardGraduationYear,
+		&s.CardAffiliation,
+		&s.CardBio,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query privacy settings: %w", err)
+	}
+
+	s.Email = model.Visibility(email)
+	s.Name = model.Visibility(name)
+	s.AvatarURL = model.Visibility(avatarURL)
+	s.GraduationYear = model.Visibility(graduationYear)
+	s.Affiliation = model.Visibility(affiliation)
+	s.Bio = model.Visibility(bio)
+	return &s, nil
+}
+
+// プロフィールの公開設定を保存（無ければ作成）
+func (p *privacyPersistence) SaveSettings(ctx context.Context, s *model.PrivacySettings) error {
+	now := time.Now()
+	_, err := p.db.ExecContext(ctx, upsertPrivacySettingsSQL,
+		s.ProfileID,
+		string(s.Email),
+		string(s.Name),
+		string(s.AvatarURL),
+		string(s.GraduationYear),
+		string(s.Affiliation),
+		string(s.Bio),
+		s.CardAvatarURL,
+		s.CardGraduationYear,
+		s.CardAffiliation,
+		s.CardBio,
+		now,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save privacy settings: %w", err)
+	}
+	return nil
+}
+
+// 同じ作品のメンバーとして登録されているか
+func (p *privacyPersistence) AreWorkCollaborators(ctx context.Context, userID, otherUserID string) (bool, error) {
+	return p.exists(ctx, checkWorkCollaboratorsSQL, userID, otherUserID)
+}
+
+// userIDのユーザーの作品が登録されたイベントの作成者・運営者か
+func (p *privacyPersistence) IsOrganizerOfUserEvent(ctx context.Context, organizerID, userID string) (bool, error) {
+	return p.exists(ctx, checkOrganizerOfUserEventSQL, organizerID, userID, organizerID)
+}
+
+func (p *privacyPersistence) exists(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	var exists bool
+	err := p.db.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check relationship: %w", err)
+	}
+	return exists, nil
+}
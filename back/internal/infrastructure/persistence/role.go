@@ -0,0 +1,92 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// SQLクエリの定数
+const (
+	selectUserRoleSQL   = "SELECT role FROM users WHERE id = ?"
+	lockUserRoleSQL     = "SELECT role FROM users WHERE id = ? FOR UPDATE"
+	lockUsersByRoleSQL  = "SELECT id FROM users WHERE role = ? ORDER BY id FOR UPDATE"
+	updateUserRoleSQL   = "UPDATE users SET role = ?, updated_at = ? WHERE id = ?"
+	countUsersByRoleSQL = "SELECT COUNT(*) FROM users WHERE role = ?"
+)
+
+type rolePersistence struct {
+	db *sqlx.DB
+}
+
+func NewRolePersistence(db *sqlx.DB) repository.RoleRepository {
+	return &rolePersistence{db: db}
+}
+
+// ユーザーの権限を取得
+func (p *rolePersistence) GetRole(ctx context.Context, userID string) (model.Role, error) {
+	var role string
+	if err := p.db.QueryRowContext(ctx, selectUserRoleSQL, userID).Scan(&role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to query user role: %w", err)
+	}
+	return model.Role(role), nil
+}
+
+// ユーザーの権限を更新
+// 管理者の行を先にロックしてから数えるため、同時に管理者を降格しても片方は最後の管理者として拒否される
+// （ロックは常に管理者の行→対象の行の順に取り、デッドロックしないようにする）
+func (p *rolePersistence) SetRole(ctx context.Context, userID string, role model.Role) (updated bool, err error) {
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil || !updated {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var admins []string
+	if err := tx.SelectContext(ctx, &admins, lockUsersByRoleSQL, string(model.RoleAdmin)); err != nil {
+		return false, fmt.Errorf("failed to lock admins: %w", err)
+	}
+
+	var current string
+	if err := tx.QueryRowContext(ctx, lockUserRoleSQL, userID).Scan(&current); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to query user role: %w", err)
+	}
+	if model.Role(current) == model.RoleAdmin && role != model.RoleAdmin && len(admins) <= 1 {
+		return false, repository.ErrLastAdmin
+	}
+
+	// updated_atを更新するため、ユーザーが存在すれば必ず1行更新される
+	if _, err := tx.ExecContext(ctx, updateUserRoleSQL, string(role), time.Now(), userID); err != nil {
+		return false, fmt.Errorf("failed to update user role: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// 指定した権限を持つユーザー数
+func (p *rolePersistence) CountByRole(ctx context.Context, role model.Role) (int, error) {
+	var count int
+	if err := p.db.QueryRowContext(ctx, countUsersByRoleSQL, string(role)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count users by role: %w", err)
+	}
+	return count, nil
+}
@@ -2,7 +2,10 @@ package persistence
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/noonyuu/nfc/back/internal/domain/model"
 	"github.com/noonyuu/nfc/back/internal/domain/repository"
@@ -30,7 +33,6 @@ func (r *RedisSessionRepository) Save(ctx context.Context, session *model.Sessio
 func (r *RedisSessionRepository) Get(ctx context.Context, key string) (*model.Session, error) {
 	// セッションを取得
 	data, err := r.client.Get(ctx, key).Result()
-	fmt.Println("data", data)
 	if err == redis.Nil {
 		// セッションが存在しない場合
 		return nil, nil
@@ -52,3 +54,128 @@ func (r *RedisSessionRepository) Delete(ctx context.Context, sessionID string) e
 	}
 	return nil
 }
+
+// 端末セッションのキー
+func deviceSessionKey(sessionID string) string {
+	return "session:" + sessionID
+}
+
+// ユーザーごとの端末セッションID一覧のキー
+func userSessionsKey(userID string) string {
+	return "user_sessions:" + userID
+}
+
+func (r *RedisSessionRepository) SaveDeviceSession(ctx context.Context, session *model.DeviceSession, expiration time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("failed to marshal device session: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, deviceSessionKey(session.ID), data, expiration)
+	pipe.SAdd(ctx, userSessionsKey(session.UserID), session.ID)
+	pipe.Expire(ctx, userSessionsKey(session.UserID), expiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to save device session in redis: %w", err)
+	}
+
+	return nil
+}
+
+// WATCHで端末セッションを監視し、トークンIDが一致する場合のみ更新する
+// 同じリフレッシュトークンで同時にローテーションした場合、片方だけが成功する
+func (r *RedisSessionRepository) CompareAndSwapDeviceSession(ctx context.Context, session *model.DeviceSession, expectedTokenID string, expiration time.Duration) (bool, error) {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal device session: %w", err)
+	}
+
+	key := deviceSessionKey(session.ID)
+	swapped := false
+	err = r.client.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, key).Bytes()
+		if err == redis.Nil {
+			// 失効済み
+			return nil
+		} else if err != nil {
+			return err
+		}
+		var stored model.DeviceSession
+		if err := json.Unmarshal(current, &stored); err != nil {
+			return fmt.Errorf("failed to unmarshal device session: %w", err)
+		}
+		if stored.TokenID != expectedTokenID {
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, expiration)
+			pipe.SAdd(ctx, userSessionsKey(session.UserID), session.ID)
+			pipe.Expire(ctx, userSessionsKey(session.UserID), expiration)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		swapped = true
+		return nil
+	}, key)
+	if errors.Is(err, redis.TxFailedErr) {
+		// 監視中に他のリクエストが更新した
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to swap device session in redis: %w", err)
+	}
+	return swapped, nil
+}
+
+func (r *RedisSessionRepository) GetDeviceSession(ctx context.Context, sessionID string) (*model.DeviceSession, error) {
+	data, err := r.client.Get(ctx, deviceSessionKey(sessionID)).Bytes()
+	if err == redis.Nil {
+		// セッションが存在しない場合
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get device session from redis: %w", err)
+	}
+
+	session := new(model.DeviceSession)
+	if err := json.Unmarshal(data, session); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal device session: %w", err)
+	}
+
+	return session, nil
+}
+
+func (r *RedisSessionRepository) ListDeviceSessions(ctx context.Context, userID string) ([]*model.DeviceSession, error) {
+	ids, err := r.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list device sessions from redis: %w", err)
+	}
+
+	sessions := make([]*model.DeviceSession, 0, len(ids))
+	for _, id := range ids {
+		session, err := r.GetDeviceSession(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			// 期限切れのセッションは一覧から取り除く
+			r.client.SRem(ctx, userSessionsKey(userID), id)
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+func (r *RedisSessionRepository) DeleteDeviceSession(ctx context.Context, userID, sessionID string) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, deviceSessionKey(sessionID))
+	pipe.SRem(ctx, userSessionsKey(userID), sessionID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete device session from redis: %w", err)
+	}
+	return nil
+}
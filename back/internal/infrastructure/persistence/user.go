@@ -17,11 +17,17 @@ import (
 
 // SQLクエリの定数
 const (
-	insertUserSQL         = "INSERT INTO users (id, first_name, last_name, email, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
-	insertAuthProviderSQL = "INSERT INTO providers (id, user_id, provider_id, provider, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
-	selectUserByIDSQL     = "SELECT id, first_name, last_name, email, created_at, updated_at FROM users WHERE id = ?"
-	selectUserByEmailSQL  = "SELECT id, first_name, last_name, email, created_at, updated_at FROM users WHERE email = ?"
-	checkAuthProviderSQL  = "SELECT 1 FROM providers WHERE user_id = ? AND provider_id = ? LIMIT 1"
+	insertUserSQL           = "INSERT INTO users (id, first_name, last_name, email, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
+	insertAuthProviderSQL   = "INSERT INTO providers (id, user_id, provider_id, provider, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
+	selectUserByIDSQL       = "SELECT id, first_name, last_name, email, created_at, updated_at FROM users WHERE id = ?"
+	selectUserByEmailSQL    = "SELECT id, first_name, last_name, email, created_at, updated_at FROM users WHERE email = ?"
+	selectUserByProviderSQL = `SELECT u.id, u.first_name, u.last_name, u.email, u.created_at, u.updated_at
+		FROM users u JOIN providers p ON p.user_id = u.id
+		WHERE p.provider = ? AND p.provider_id = ?`
+	selectProvidersByUserSQL = "SELECT id, user_id, provider_id, provider, created_at, updated_at FROM providers WHERE user_id = ? ORDER BY created_at"
+	// MySQLでは削除対象のテーブルをサブクエリで直接参照できないため派生テーブルを経由する
+	deleteProviderIfNotLastSQL = `DELETE FROM providers WHERE user_id = ? AND provider = ?
+		AND (SELECT cnt FROM (SELECT COUNT(*) AS cnt FROM providers WHERE user_id = ?) AS t) > 1`
 )
 
 type userPersistence struct {
@@ -97,9 +103,75 @@ func (u *userPersistence) GetByUserID(ctx context.Context, userID string) (*mode
 }
 
 // メールアドレスからユーザー検索
-func (u *userPersistence) FindByEmail(ctx context.Context, email, providerId, provider string) (*model.User, error) {
+func (u *userPersistence) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	return u.findUser(ctx, selectUserByEmailSQL, email)
+}
+
+// 認証プロバイダーのアカウントに紐づくユーザーを検索
+func (u *userPersistence) FindByProvider(ctx context.Context, provider, providerID string) (*model.User, error) {
+	return u.findUser(ctx, selectUserByProviderSQL, provider, providerID)
+}
+
+// ユーザーに紐づく認証プロバイダー一覧
+func (u *userPersistence) ListProviders(ctx context.Context, userID string) ([]*model.Provider, error) {
+	rows, err := u.db.QueryContext(ctx, selectProvidersByUserSQL, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query providers: %w", err)
+	}
+	defer rows.Close()
+
+	var providers []*model.Provider
+	for rows.Next() {
+		provider := &model.Provider{}
+		var createdAt, updatedAt time.Time
+		if err := rows.Scan(&provider.ID, &provider.UserID, &provider.ProviderID, &provider.Provider, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan provider: %w", err)
+		}
+		provider.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		provider.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
+		providers = append(providers, provider)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate providers: %w", err)
+	}
+
+	return providers, nil
+}
+
+// 既存のユーザーに認証プロバイダーを紐づける
+func (u *userPersistence) LinkProvider(ctx context.Context, userID, provider, providerID string) error {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return fmt.Errorf("failed to generate UUID: %w", err)
+	}
+
+	now := time.Now()
+	if _, err := u.db.ExecContext(ctx, insertAuthProviderSQL, id, userID, providerID, provider, now, now); err != nil {
+		return fmt.Errorf("failed to insert auth provider: %w", err)
+	}
+
+	return nil
+}
+
+// 認証プロバイダーの紐づけを解除（最後の1つは削除しない）
+func (u *userPersistence) UnlinkProvider(ctx context.Context, userID, provider string) (bool, error) {
+	result, err := u.db.ExecContext(ctx, deleteProviderIfNotLastSQL, userID, provider, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete auth provider: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+// 1件のユーザーを取得（存在しない場合はnil）
+func (u *userPersistence) findUser(ctx context.Context, query string, args ...interface{}) (*model.User, error) {
 	user := &model.User{}
-	err := u.db.QueryRowContext(ctx, selectUserByEmailSQL, email).Scan(
+	err := u.db.QueryRowContext(ctx, query, args...).Scan(
 		&user.ID,
 		&user.FirstName,
 		&user.LastName,
@@ -107,33 +179,11 @@ func (u *userPersistence) FindByEmail(ctx context.Context, email, providerId, pr
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
-
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
-		return nil, fmt.Errorf("failed to query user by email: %w", err)
-	}
-
-	// providersテーブルに認証プロバイダーが存在するか確認
-	var exists bool
-	err = u.db.QueryRowContext(ctx, checkAuthProviderSQL, user.ID, providerId).Scan(&exists)
-
-	if errors.Is(err, sql.ErrNoRows) {
-		providerID, err := uuid.NewV7()
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate UUID: %w", err)
-		}
-
-		now := time.Now()
-		_, err = u.db.ExecContext(ctx, insertAuthProviderSQL,
-			providerID, user.ID, providerId, provider, now, now)
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to insert auth provider: %w", err)
-		}
-	} else if err != nil {
-		return nil, fmt.Errorf("failed to query auth provider: %w", err)
+		return nil, fmt.Errorf("failed to query user: %w", err)
 	}
 
 	return user, nil
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/config"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/markbates/goth"
+	"github.com/markbates/goth/gothic"
+)
+
+const (
+	// 連携の開始から完了までの有効期限
+	linkIntentExpireTime = 10 * time.Minute
+	linkIntentCookieName = "link_intent"
+	linkIntentCookiePath = "/api/v1/auth/"
+
+	// アカウント競合ページに渡す理由
+	conflictReasonEmailInUse    = "email_in_use"
+	conflictReasonLinkedToOther = "linked_to_other_user"
+	conflictReasonAlreadyLinked = "provider_already_linked"
+)
+
+func linkIntentKey(nonce string) string {
+	return "link_intent:" + nonce
+}
+
+// ログイン中のユーザーにプロバイダーを連携するための認証を開始する
+func (u *AuthController) BeginLinkProvider(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID := u.currentUserID(c)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	// 連携の意図をRedisに保存し、コールバックで照合する
+	nonce, err := uuid.NewRandom()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start linking"})
+		return
+	}
+	if _, err := u.sessionUseCase.Save(ctx, linkIntentKey(nonce.String()), userID, linkIntentExpireTime); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start linking"})
+		return
+	}
+
+	cookie := u.cookies.Cookie(linkIntentCookieName, nonce.String(), linkIntentExpireTime)
+	cookie.Path = linkIntentCookiePath
+	http.SetCookie(c.Writer, cookie)
+
+	c.Request = ContextWithProviderName(c, c.Param("provider"))
+	gothic.BeginAuthHandler(c.Writer, c.Request)
+}
+
+// 連携の意図を取り出して削除し、連携対象のユーザーIDを返す（連携フローでなければ空文字）
+func (u *AuthController) consumeLinkIntent(c *gin.Context) (string, error) {
+	nonce, err := c.Cookie(linkIntentCookieName)
+	if err != nil || nonce == "" {
+		return "", nil
+	}
+
+	cookie := u.cookies.Expired(linkIntentCookieName)
+	cookie.Path = linkIntentCookiePath
+	http.SetCookie(c.Writer, cookie)
+
+	ctx := c.Request.Context()
+	session, err := u.sessionUseCase.Get(ctx, linkIntentKey(nonce))
+	if err != nil {
+		return "", err
+	}
+	if session == nil {
+		return "", nil
+	}
+	if err := u.sessionUseCase.Delete(ctx, linkIntentKey(nonce)); err != nil {
+		return "", err
+	}
+
+	userID, _ := session.Value.(string)
+	return userID, nil
+}
+
+// 認証したプロバイダーを連携を開始したユーザーに紐づける
+func (u *AuthController) completeLinkProvider(c *gin.Context, linkUserID string, userGoth goth.User) {
+	// 連携の開始後にログアウト・別ユーザーでログインしていないか確認
+	if u.currentUserID(c) != linkUserID {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+		return
+	}
+
+	err := u.authUseCase.LinkProvider(c.Request.Context(), linkUserID, userGoth)
+	switch {
+	case errors.Is(err, usecase.ErrProviderLinkedToOtherUser):
+		u.redirectToAccountConflict(c, userGoth.Provider, conflictReasonLinkedToOther)
+	case errors.Is(err, usecase.ErrProviderAlreadyLinked):
+		u.redirectToAccountConflict(c, userGoth.Provider, conflictReasonAlreadyLinked)
+	case err != nil:
+		slog.ErrorContext(c.Request.Context(), "failed to link provider", "error", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to link provider"})
+	default:
+		u.audit(c, usecase.AuditEntry{
+			ActorID:    linkUserID,
+			Action:     domainModel.AuditActionLinkProvider,
+			TargetType: domainModel.AuditTargetUser,
+			TargetID:   linkUserID,
+			After:      map[string]string{"provider": userGoth.Provider},
+		})
+		c.Redirect(http.StatusFound, u.hostURL)
+	}
+}
+
+// アクセストークンのクッキーからログイン中のユーザーIDを取得（未ログインの場合は空文字）
+func (u *AuthController) currentUserID(c *gin.Context) string {
+	accessToken, err := c.Cookie("access_token")
+	if err != nil || accessToken == "" {
+		return ""
+	}
+	claims, err := config.ParseAccessToken(accessToken)
+	if err != nil {
+		return ""
+	}
+	if claims.SessionID != "" {
+		active, err := u.sessionUseCase.IsDeviceSessionActive(c.Request.Context(), claims.Id, claims.SessionID)
+		if err != nil || !active {
+			return ""
+		}
+	}
+	return claims.Id
+}
+
+// アカウント競合ページへリダイレクト
+func (u *AuthController) redirectToAccountConflict(c *gin.Context, provider string, reason string) {
+	query := url.Values{}
+	query.Set("provider", provider)
+	query.Set("reason", reason)
+	c.Redirect(http.StatusFound, u.hostURL+"account-conflict?"+query.Encode())
+}
@@ -2,17 +2,18 @@ package handler
 
 import (
 	"context"
-	"log"
+	"errors"
+	"log/slog"
 	"net/http"
-	"os"
-	"time"
 
-	"github.com/noonyuu/nfc/back/graph/model"
 	"github.com/noonyuu/nfc/back/graph/resolver"
 	"github.com/noonyuu/nfc/back/internal/config"
+	domainModel "github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/metrics"
 	"github.com/noonyuu/nfc/back/internal/usecase"
 
 	"github.com/gin-gonic/gin"
+	"github.com/markbates/goth"
 	"github.com/markbates/goth/gothic"
 )
 
@@ -22,36 +23,99 @@ func ContextWithProviderName(ctx *gin.Context, provider string) *http.Request {
 }
 
 type AuthController struct {
-	authUseCase    usecase.AuthUsecase
-	sessionUseCase usecase.SessionUsecase
-	graphql        *resolver.Resolver
+	authUseCase     usecase.AuthUsecase
+	sessionUseCase  usecase.SessionUsecase
+	auditLogUseCase usecase.AuditLogUsecase
+	graphql         *resolver.Resolver
+	cookies         *config.CookieConfig
+	hostURL         string
 }
 
-func NewAuthController(authUseCase usecase.AuthUsecase, sessionUseCase usecase.SessionUsecase, graphql *resolver.Resolver) *AuthController {
-	return &AuthController{authUseCase: authUseCase, sessionUseCase: sessionUseCase, graphql: graphql}
+func NewAuthController(authUseCase usecase.AuthUsecase, sessionUseCase usecase.SessionUsecase, auditLogUseCase usecase.AuditLogUsecase, graphql *resolver.Resolver, cfg *config.Config) *AuthController {
+	return &AuthController{authUseCase: authUseCase, sessionUseCase: sessionUseCase, auditLogUseCase: auditLogUseCase, graphql: graphql, cookies: &cfg.Cookie, hostURL: cfg.HostURL}
 }
 
-// 期限を定数で定義
-const (
-	// アクセストークンの有効期限
-	AccessTokenExpireTime = 15 * time.Minute
-	// リフレッシュトークンの有効期限
-	RefreshTokenExpireTime = 30 * 24 * time.Hour // 30日
-)
+// リクエストから端末情報を取得（サーバーのミドルウェアでcontextに設定される）
+func deviceInfo(c *gin.Context) usecase.DeviceInfo {
+	return usecase.DeviceInfoFromContext(c.Request.Context())
+}
+
+// 認証に関する操作を監査ログに記録（記録に失敗しても処理は続ける）
+func (u *AuthController) audit(c *gin.Context, entry usecase.AuditEntry) {
+	if err := u.auditLogUseCase.Record(c.Request.Context(), entry); err != nil {
+		slog.ErrorContext(c.Request.Context(), "failed to record audit log", "error", err)
+	}
+}
+
+// リフレッシュトークンをクッキーにセット
+func (u *AuthController) setRefreshTokenCookie(c *gin.Context, refreshToken string) {
+	http.SetCookie(c.Writer, u.cookies.Cookie("refresh_token", refreshToken, u.cookies.RefreshTokenMaxAge))
+}
+
+// アクセストークンをクッキーにセット
+func (u *AuthController) setAccessTokenCookie(c *gin.Context, accessToken string) {
+	http.SetCookie(c.Writer, u.cookies.Cookie("access_token", accessToken, u.cookies.AccessTokenMaxAge))
+}
+
+// リフレッシュトークンをローテーションし、失敗時はレスポンスを書き込んでnilを返す
+func (u *AuthController) rotateRefreshToken(c *gin.Context, refreshToken string) (*domainModel.DeviceSession, string) {
+	session, newRefreshToken, err := u.sessionUseCase.RotateRefreshToken(c.Request.Context(), refreshToken, deviceInfo(c))
+	switch {
+	case errors.Is(err, usecase.ErrRefreshTokenReused):
+		slog.WarnContext(c.Request.Context(), "refresh token reuse detected, session revoked")
+		entry := usecase.AuditEntry{Action: domainModel.AuditActionRefreshTokenReuse, TargetType: domainModel.AuditTargetDeviceSession}
+		if claims, err := config.ParseRefreshToken(refreshToken); err == nil {
+			entry.ActorID = claims.Id
+			entry.TargetID = claims.SessionID
+		}
+		u.audit(c, entry)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token reuse detected"})
+		return nil, ""
+	case errors.Is(err, usecase.ErrInvalidRefreshToken):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+		return nil, ""
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to rotate refresh token"})
+		return nil, ""
+	}
+
+	u.setRefreshTokenCookie(c, newRefreshToken)
+	u.audit(c, usecase.AuditEntry{
+		ActorID:    session.UserID,
+		Action:     domainModel.AuditActionRefresh,
+		TargetType: domainModel.AuditTargetDeviceSession,
+		TargetID:   session.ID,
+	})
+	return session, newRefreshToken
+}
 
 func (u *AuthController) GetAuthCallbackFunction(c *gin.Context) {
-	log.Print("getAuthCallbackFunction")
 	ctx := c.Request.Context()
 	provider := c.Param("provider")
 	c.Request = ContextWithProviderName(c, provider)
 
+	// 連携フローの場合は、認証の完了前に連携の意図を取り出す
+	linkUserID, err := u.consumeLinkIntent(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read link request"})
+		return
+	}
+
 	// 認証情報を取得
 	userGoth, err := gothic.CompleteUserAuth(c.Writer, c.Request)
 	if err != nil {
+		if linkUserID == "" {
+			countLogin(provider, metrics.LoginFailed)
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
+	if linkUserID != "" {
+		u.completeLinkProvider(c, linkUserID, userGoth)
+		return
+	}
+
 	// ユーザーデータをDTO形式に変換
 	userData := &usecase.CreateUserDTO{
 		Name:      userGoth.Name,
@@ -59,68 +123,59 @@ func (u *AuthController) GetAuthCallbackFunction(c *gin.Context) {
 		AvatarURL: userGoth.AvatarURL,
 	}
 
-	// ユーザーがすでに存在するかを確認
-	existingUser, err := u.authUseCase.FindByEmail(ctx, userGoth.Email, userGoth.UserID, userGoth.Provider)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// 紐づいているユーザーでログイン（未登録の場合は新規作成）
+	userRes, err := u.authUseCase.Login(ctx, userData, userGoth)
+	if errors.Is(err, usecase.ErrAccountConflict) {
+		// メールアドレスが一致しても自動では紐づけない
+		countLogin(provider, metrics.LoginConflict)
+		u.redirectToAccountConflict(c, userGoth.Provider, conflictReasonEmailInUse)
 		return
 	}
-	var userRes *model.User
-
-	if err == nil && existingUser != nil {
-		// ユーザーが存在する場合は作成しない
-		userRes = existingUser
-	} else {
-		// ユーザーが存在しない場合は新規作成
-		userRes, err = u.authUseCase.Create(ctx, userData, userGoth)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-		}
-	}
-
-	// アクセストークンとリフレッシュトークンを生成
-	accessToken, err := config.GenerateAccessToken(userRes.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate access token"})
+		countLogin(provider, metrics.LoginFailed)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	refreshToken, err := config.GenerateRefreshToken(userRes.ID)
+	// 端末セッションを作成し、リフレッシュトークンを発行
+	session, refreshToken, err := u.sessionUseCase.StartDeviceSession(ctx, userRes.ID, deviceInfo(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate refresh token"})
+		countLogin(provider, metrics.LoginFailed)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save refresh token"})
 		return
 	}
 
-	// リフレッシュトークンをRedisに保存
-	_, err = u.sessionUseCase.Save(ctx, "refresh_token:"+userRes.ID, refreshToken, RefreshTokenExpireTime) // 30日間有効
+	// アクセストークンを生成
+	accessToken, err := config.GenerateAccessToken(userRes.ID, session.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save refresh token"})
+		countLogin(provider, metrics.LoginFailed)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate access token"})
 		return
 	}
 
 	// クッキーにセット
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "access_token",
-		Value:    accessToken,
-		HttpOnly: true,
-		Secure:   false, // 本番環境では true にする
-		SameSite: http.SameSiteLaxMode,
-		Path:     "/",
-		MaxAge:   60 * 15, // 15分
-	})
+	u.setAccessTokenCookie(c, accessToken)
+
+	u.setRefreshTokenCookie(c, refreshToken)
 
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		HttpOnly: true,
-		Secure:   false, // 本番環境では true にする
-		SameSite: http.SameSiteLaxMode,
-		Path:     "/",
-		MaxAge:   60 * 60 * 24 * 30, // 30日
+	u.audit(c, usecase.AuditEntry{
+		ActorID:    userRes.ID,
+		Action:     domainModel.AuditActionLogin,
+		TargetType: domainModel.AuditTargetDeviceSession,
+		TargetID:   session.ID,
+		After:      map[string]string{"provider": userGoth.Provider},
 	})
+	countLogin(provider, metrics.LoginSucceeded)
 
-	c.Redirect(http.StatusFound, os.Getenv("HOST_URL"))
+	c.Redirect(http.StatusFound, u.hostURL)
+}
+
+// ログイン数を記録（未登録のプロバイダー名はラベルに使わない）
+func countLogin(provider string, result string) {
+	if _, err := goth.GetProvider(provider); err != nil {
+		provider = "unknown"
+	}
+	metrics.Logins.WithLabelValues(provider, result).Inc()
 }
 
 func (u *AuthController) RefreshAccessToken(c *gin.Context) {
@@ -137,21 +192,13 @@ func (u *AuthController) RefreshAccessToken(c *gin.Context) {
 		}
 	}
 
-	// リフレッシュトークンを検証
-	claims, err := config.ParseToken(refreshToken)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired refresh token"})
+	// リフレッシュトークンを検証してローテーション
+	session, _ := u.rotateRefreshToken(c, refreshToken)
+	if session == nil {
 		return
 	}
 
-	userID := claims.Id
-
-	// Redisに保存されているリフレッシュトークンと一致するか確認
-	storedToken, err := u.sessionUseCase.Get(ctx, "refresh_token:"+userID)
-	if err != nil || storedToken.Value != refreshToken {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
-		return
-	}
+	userID := session.UserID
 
 	// graphqlサーバからユーザー情報を取得
 	userInfo, err := u.graphql.Query().ProfileByUserID(ctx, userID)
@@ -161,22 +208,14 @@ func (u *AuthController) RefreshAccessToken(c *gin.Context) {
 	}
 
 	// 新しいアクセストークンを生成
-	newAccessToken, err := config.GenerateAccessToken(userID)
+	newAccessToken, err := config.GenerateAccessToken(userID, session.ID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate new access token"})
 		return
 	}
 
-	// クッキーを更新 - session_idは不要
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "access_token",
-		Value:    newAccessToken,
-		HttpOnly: true,
-		Secure:   false, // 本番環境では true にする
-		SameSite: http.SameSiteLaxMode,
-		Path:     "/",
-		MaxAge:   60 * 15, // 15分
-	})
+	// クッキーを更新
+	u.setAccessTokenCookie(c, newAccessToken)
 
 	c.JSON(http.StatusOK, gin.H{
 		"access_token": newAccessToken,
@@ -193,13 +232,12 @@ func (u *AuthController) RefreshAccessToken(c *gin.Context) {
 }
 
 func (u *AuthController) GetUserAfterAuthorization(c *gin.Context) {
-	log.Print("GetUserAfterAuthorization")
 	ctx := c.Request.Context()
 
 	// アクセストークンからユーザー情報を取得
 	accessToken, err := c.Cookie("access_token")
 	if err == nil && accessToken != "" {
-		claims, err := config.ParseToken(accessToken)
+		claims, err := config.ParseAccessToken(accessToken)
 		if err == nil {
 			userID := claims.Id
 			// graphqlサーバからユーザー情報を取得
@@ -209,22 +247,14 @@ func (u *AuthController) GetUserAfterAuthorization(c *gin.Context) {
 				return
 			}
 			// 新しいアクセストークンを生成
-			newAccessToken, err := config.GenerateAccessToken(userID)
+			newAccessToken, err := config.GenerateAccessToken(userID, claims.SessionID)
 			if err != nil {
 				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate new access token"})
 				return
 			}
 
 			// クッキーを更新
-			http.SetCookie(c.Writer, &http.Cookie{
-				Name:     "access_token",
-				Value:    newAccessToken,
-				HttpOnly: true,
-				Secure:   false, // 本番環境では true にする
-				SameSite: http.SameSiteLaxMode,
-				Path:     "/",
-				MaxAge:   60 * 15, // 15分
-			})
+			u.setAccessTokenCookie(c, newAccessToken)
 			// ユーザー情報を返す
 			c.JSON(http.StatusOK, gin.H{
 				"id":              userInfo.ID,
@@ -245,89 +275,67 @@ func (u *AuthController) GetUserAfterAuthorization(c *gin.Context) {
 		return
 	}
 
-	// リフレッシュトークンを検証
-	claims, err := config.ParseToken(refreshToken)
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+	// リフレッシュトークンを検証してローテーション
+	session, _ := u.rotateRefreshToken(c, refreshToken)
+	if session == nil {
 		return
 	}
 
-	userID := claims.Id
+	userID := session.UserID
 
-	// Redisに保存されているリフレッシュトークンと一致するか確認
-	storedToken, err := u.sessionUseCase.Get(ctx, "refresh_token:"+userID)
-	if err != nil || storedToken.Value != refreshToken {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
+	// graphqlサーバからユーザー情報を取得
+	userInfo, err := u.graphql.Query().ProfileByUserID(ctx, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user info"})
 		return
-	} else {
-		// graphqlサーバからユーザー情報を取得
-		userInfo, err := u.graphql.Query().ProfileByUserID(ctx, userID)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get user info"})
-			return
-		}
-		// 新しいアクセストークンを生成
-		newAccessToken, err := config.GenerateAccessToken(userID)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate new access token"})
-			return
-		}
-
-		// クッキーを更新
-		http.SetCookie(c.Writer, &http.Cookie{
-			Name:     "access_token",
-			Value:    newAccessToken,
-			HttpOnly: true,
-			Secure:   false, // 本番環境では true にする
-			SameSite: http.SameSiteLaxMode,
-			Path:     "/",
-			MaxAge:   60 * 15, // 15分
-		})
-		// ユーザー情報を返す
-		c.JSON(http.StatusOK, gin.H{
-			"id": userInfo.ID,
-		})
+	}
+	// 新しいアクセストークンを生成
+	newAccessToken, err := config.GenerateAccessToken(userID, session.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate new access token"})
 		return
 	}
+
+	// クッキーを更新
+	u.setAccessTokenCookie(c, newAccessToken)
+	// ユーザー情報を返す
+	c.JSON(http.StatusOK, gin.H{
+		"id": userInfo.ID,
+	})
 }
 
 // ログアウト処理
 func (u *AuthController) Logout(c *gin.Context) {
-	log.Print("Logout")
 	ctx := c.Request.Context()
 
-	// アクセストークンからユーザーIDを取得
-	accessToken, err := c.Cookie("access_token")
-	if err == nil && accessToken != "" {
-		claims, err := config.ParseToken(accessToken)
-		if err == nil {
-			userID := claims.Id
+	// アクセストークンまたはリフレッシュトークンから現在の端末セッションを特定
+	for _, name := range []string{"access_token", "refresh_token"} {
+		token, err := c.Cookie(name)
+		if err != nil || token == "" {
+			continue
+		}
+		claims, err := config.ParseToken(token)
+		if err != nil || claims.SessionID == "" {
+			continue
+		}
 
-			// リフレッシュトークンをRedisから削除
-			u.sessionUseCase.Delete(ctx, "refresh_token:"+userID)
+		// この端末のセッションのみ失効させる
+		if err := u.sessionUseCase.RevokeDeviceSession(ctx, claims.Id, claims.SessionID); err != nil && !errors.Is(err, usecase.ErrResourceNotFound) {
+			slog.ErrorContext(c.Request.Context(), "failed to revoke session", "error", err)
 		}
+		u.audit(c, usecase.AuditEntry{
+			ActorID:    claims.Id,
+			Action:     domainModel.AuditActionLogout,
+			TargetType: domainModel.AuditTargetDeviceSession,
+			TargetID:   claims.SessionID,
+		})
+		break
 	}
 
 	// クッキーを削除
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "access_token",
-		Value:    "",
-		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteLaxMode,
-		Path:     "/",
-		MaxAge:   -1,
-	})
+	http.SetCookie(c.Writer, u.cookies.Expired("access_token"))
 
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "refresh_token",
-		Value:    "",
-		HttpOnly: true,
-		Secure:   false,
-		SameSite: http.SameSiteLaxMode,
-		Path:     "/",
-		MaxAge:   -1,
-	})
+	http.SetCookie(c.Writer, u.cookies.Expired("refresh_token"))
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Logged out successfully",
@@ -2,18 +2,23 @@ package interfaces
 
 import (
 	"net/http"
-	"os"
 
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/csrf"
 	"github.com/noonyuu/nfc/back/internal/interfaces/handler"
 
 	"github.com/gin-gonic/gin"
 	"github.com/markbates/goth/gothic"
 )
 
-func NewRouter(userHandler *handler.AuthController) http.Handler {
-	r := gin.Default()
+func NewRouter(userHandler *handler.AuthController, rateLimit gin.HandlerFunc, csrfProtector *csrf.Protector, allowOrigin string) *gin.Engine {
+	// アクセスログはサーバーのミドルウェアでリクエストIDと合わせて出力する
+	r := gin.New()
+	r.Use(gin.Recovery())
 
-	r.Use(newCORS())
+	r.Use(newCORS(allowOrigin))
+	r.Use(rateLimit)
+	r.Use(csrfProtector.Gin())
 
 	r.GET("/ping", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"messages": "ping /ping"})
@@ -24,26 +29,32 @@ func NewRouter(userHandler *handler.AuthController) http.Handler {
 		v1.GET("/ping", func(c *gin.Context) {
 			c.JSON(http.StatusOK, gin.H{"messages": "ping /api/v1/auth"})
 		})
+		// ログイン画面に表示する認証プロバイダー一覧
+		v1.GET("/providers", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"providers": auth.EnabledProviders()})
+		})
+		// フロントエンドが状態を変更するリクエストに付けるCSRFトークン
+		v1.GET("/csrf", csrfProtector.TokenHandler)
 		v1.GET("/:provider", func(c *gin.Context) {
 			provider := c.Param("provider")
 			c.Request = handler.ContextWithProviderName(c, provider)
 			gothic.BeginAuthHandler(c.Writer, c.Request)
 		})
 		v1.GET("/:provider/callback", userHandler.GetAuthCallbackFunction)
+		v1.GET("/link/:provider", userHandler.BeginLinkProvider)
 		v1.GET("/getUser", userHandler.GetUserAfterAuthorization)
-		v1.GET("/logout", userHandler.Logout)
+		v1.POST("/logout", userHandler.Logout)
 		v1.POST("/refresh", userHandler.RefreshAccessToken)
 	}
 
 	return r
 }
 
-func newCORS() gin.HandlerFunc {
-	hostUrl := os.Getenv("HOST_URL")
+func newCORS(allowOrigin string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Writer.Header().Set("Access-Control-Allow-Origin", hostUrl)
+		c.Writer.Header().Set("Access-Control-Allow-Origin", allowOrigin)
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Accept, Authorization, "+csrf.HeaderName)
 		c.Writer.Header().Set("Access-Control-Max-Age", "86400")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		if c.Request.Method == "OPTIONS" {
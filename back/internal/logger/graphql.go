@@ -0,0 +1,43 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// GraphQLの操作名と変数（機密情報はマスク）をログに出力する
+// 以降のログには操作名が付与される
+func GraphQL(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	name := oc.OperationName
+	if name == "" {
+		name = "anonymous"
+	}
+	ctx = WithAttrs(ctx, slog.String("graphql_operation", name))
+
+	opType := ""
+	if oc.Operation != nil {
+		opType = string(oc.Operation.Operation)
+	}
+	slog.InfoContext(ctx, "graphql operation",
+		slog.String("type", opType),
+		slog.Any("variables", Redact(oc.Variables)),
+	)
+
+	start := time.Now()
+	responses := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp != nil && len(resp.Errors) > 0 {
+			slog.WarnContext(ctx, "graphql operation returned errors",
+				slog.Int("errors", len(resp.Errors)),
+				slog.String("first_error", resp.Errors[0].Message),
+				slog.Duration("duration", time.Since(start)),
+			)
+		}
+		return resp
+	}
+}
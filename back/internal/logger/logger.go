@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ログ出力の設定
+type Options struct {
+	Level slog.Level
+	// JSON形式で出力する（falseの場合はテキスト形式）
+	JSON bool
+}
+
+// slogのデフォルトロガーを設定する
+// 標準のlogパッケージの出力もこのロガーを通すため、既存のlog.Printfも構造化・マスクされる
+func Setup(w io.Writer, opts Options) *slog.Logger {
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+	var h slog.Handler
+	if opts.JSON {
+		h = slog.NewJSONHandler(w, handlerOpts)
+	} else {
+		h = slog.NewTextHandler(w, handlerOpts)
+	}
+
+	l := slog.New(&handler{next: h})
+	slog.SetDefault(l)
+	return l
+}
+
+// contextの属性の付与と、機密情報のマスクを行うハンドラー
+type handler struct {
+	next slog.Handler
+}
+
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, redactString(r.Message), r.PC)
+	if ctx != nil {
+		for _, a := range attrsFromContext(ctx) {
+			out.AddAttrs(redactAttr(a))
+		}
+		// トレースと突き合わせられるようにする
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			out.AddAttrs(
+				slog.String("trace_id", sc.TraceID().String()),
+				slog.String("span_id", sc.SpanID().String()),
+			)
+		}
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = redactAttr(a)
+	}
+	return &handler{next: h.next.WithAttrs(redacted)}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{next: h.next.WithGroup(name)}
+}
+
+type attrsKey struct{}
+
+// 以降のログに出力する属性をcontextに追加する
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	current := attrsFromContext(ctx)
+	merged := make([]slog.Attr, 0, len(current)+len(attrs))
+	merged = append(merged, current...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+func attrsFromContext(ctx context.Context) []slog.Attr {
+	attrs, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	return attrs
+}
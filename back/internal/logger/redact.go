@@ -0,0 +1,137 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// 値を出力しない属性名（小文字にし、_と-を除いて部分一致で判定する）
+var sensitiveKeys = []string{
+	"token",
+	"password",
+	"secret",
+	"authorization",
+	"cookie",
+	"email",
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// JWT・パーソナルアクセストークン・Bearerトークン
+	jwtPattern    = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]*`)
+	patPattern    = regexp.MustCompile(`hmpat_[A-Za-z0-9_\-]+`)
+	bearerPattern = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._\-]+`)
+)
+
+// 機密情報とみなす属性名か
+func IsSensitiveKey(key string) bool {
+	k := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	for _, s := range sensitiveKeys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// 文字列に含まれるメールアドレス・トークンをマスクする
+func redactString(s string) string {
+	s = jwtPattern.ReplaceAllString(s, redacted)
+	s = patPattern.ReplaceAllString(s, redacted)
+	s = bearerPattern.ReplaceAllString(s, redacted)
+	return emailPattern.ReplaceAllString(s, redacted)
+}
+
+func redactAttr(a slog.Attr) slog.Attr {
+	if IsSensitiveKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+	return slog.Attr{Key: a.Key, Value: redactValue(a.Value)}
+}
+
+func redactValue(v slog.Value) slog.Value {
+	v = v.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.StringValue(redactString(v.String()))
+	case slog.KindGroup:
+		attrs := v.Group()
+		out := make([]slog.Attr, len(attrs))
+		for i, a := range attrs {
+			out[i] = redactAttr(a)
+		}
+		return slog.GroupValue(out...)
+	case slog.KindAny:
+		return slog.AnyValue(Redact(v.Any()))
+	}
+	return v
+}
+
+// GraphQLの変数などの値から機密情報を取り除く
+func Redact(v any) any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return redactString(v)
+	case error:
+		return redactString(v.Error())
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, val := range v {
+			if IsSensitiveKey(k) {
+				out[k] = redacted
+				continue
+			}
+			out[k] = Redact(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, val := range v {
+			out[i] = Redact(val)
+		}
+		return out
+	case json.Number:
+		return v
+	case fmt.Stringer:
+		return redactString(v.String())
+	}
+
+	// 構造体や型付きのマップ・スライスは、JSONのフィールド名のマップにしてから取り除く
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return Redact(jsonValue(v))
+	}
+	return v
+}
+
+// JSONに変換して読み直した値（数値はjson.Numberのまま残す）
+// 変換できない値は内容が分からないため、全てマスクする
+func jsonValue(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return redacted
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var out any
+	if err := d.Decode(&out); err != nil {
+		return redacted
+	}
+	return out
+}
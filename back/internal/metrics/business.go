@@ -0,0 +1,38 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// ログインの結果
+const (
+	LoginSucceeded = "success"
+	LoginFailed    = "failure"
+	// メールアドレスが既存のアカウントと競合した
+	LoginConflict = "conflict"
+)
+
+var (
+	// プロバイダーごとのログイン数
+	Logins = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "Number of OAuth logins by provider and result.",
+	}, []string{"provider", "result"})
+
+	// 作成された作品数
+	WorksCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "works_created_total",
+		Help:      "Number of works created.",
+	})
+
+	// NFCカードの閲覧数
+	CardViews = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "nfc_card_views_total",
+		Help:      "Number of NFC card views.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(Logins, WorksCreated, CardViews)
+}
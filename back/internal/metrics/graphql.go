@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/persistedquery"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "graphql_operation_duration_seconds",
+		Help:      "GraphQL operation latency by operation name and type.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "type"})
+	operationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "graphql_operation_errors_total",
+		Help:      "Number of GraphQL operations that returned errors.",
+	}, []string{"operation", "type"})
+	resolverDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "graphql_resolver_duration_seconds",
+		Help:      "GraphQL resolver latency by object and field.",
+		Buckets:   []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"object", "field"})
+	resolverErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "graphql_resolver_errors_total",
+		Help:      "Number of GraphQL resolver errors by object and field.",
+	}, []string{"object", "field"})
+)
+
+func init() {
+	Registry.MustRegister(operationDuration, operationErrors, resolverDuration, resolverErrors)
+}
+
+// 操作ごと・リゾルバーごとの処理時間とエラー数を計測するgqlgenの拡張
+type GraphQL struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = GraphQL{}
+
+func (GraphQL) ExtensionName() string {
+	return "Metrics"
+}
+
+func (GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	resp := next(ctx)
+
+	oc := graphql.GetOperationContext(ctx)
+	name := operationLabel(ctx, oc)
+	opType := "unknown"
+	if oc.Operation != nil {
+		opType = string(oc.Operation.Operation)
+	}
+	operationDuration.WithLabelValues(name, opType).Observe(time.Since(start).Seconds())
+	if resp == nil || len(resp.Errors) > 0 {
+		operationErrors.WithLabelValues(name, opType).Inc()
+	}
+	return resp
+}
+
+// 操作のラベル
+// 操作名はクライアントが自由に付けられ、系列が際限なく増えるため、
+// マニフェストに登録されたクエリのみ操作名（無名の場合はハッシュ）を使い、それ以外は"other"にまとめる
+func operationLabel(ctx context.Context, oc *graphql.OperationContext) string {
+	hash, ok := persistedquery.RegisteredHash(ctx)
+	if !ok {
+		return "other"
+	}
+	// operationNameではなく、登録されたクエリから解析した操作の名前を使う
+	if oc.Operation != nil && oc.Operation.Name != "" {
+		return oc.Operation.Name
+	}
+	return hash
+}
+
+func (GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	// 構造体のフィールドを返すだけのものは計測しない
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	resolverDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		resolverErrors.WithLabelValues(fc.Object, fc.Field.Name).Inc()
+	}
+	return res, err
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+)
+
+const namespace = "hackmeet"
+
+// アプリケーションのメトリクスを登録するレジストリ
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// MySQL・Redisのコネクションプールの状態を登録する
+func RegisterPools(db *sqlx.DB, rdb *redis.Client) {
+	Registry.MustRegister(
+		collectors.NewDBStatsCollector(db.DB, "mysql"),
+		newRedisPoolCollector(rdb),
+	)
+}
+
+// GET /metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,70 @@
+package persistedquery
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// マニフェストに登録されたクエリを扱うgqlgenの拡張
+// ハッシュだけのリクエストにはマニフェストのクエリを補う（APQのキャッシュになくても実行できる）
+// Enforceの場合は、マニフェストにないクエリを全て拒否する（本番環境で任意のクエリを実行させない）
+// APQより先に登録し、拒否したクエリがAPQのキャッシュに保存されないようにする
+type Allowlist struct {
+	Manifest Manifest
+	Enforce  bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = Allowlist{}
+
+func (a Allowlist) ExtensionName() string {
+	return "PersistedQueryAllowlist"
+}
+
+func (a Allowlist) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a Allowlist) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	hash := requestedHash(rawParams)
+	if rawParams.Query != "" {
+		hash = Hash(rawParams.Query)
+	}
+
+	query, ok := a.Manifest[hash]
+	if !ok {
+		if a.Enforce {
+			return apperror.Forbidden("許可されていないクエリです。").With("reason", "PERSISTED_QUERY_NOT_ALLOWED").GQLError()
+		}
+		return nil
+	}
+	graphql.GetOperationContext(ctx).Stats.SetExtension(statsExtension, hash)
+	if rawParams.Query == "" {
+		rawParams.Query = query
+	}
+	return nil
+}
+
+const statsExtension = "PersistedQueryAllowlist"
+
+// マニフェストに登録されたクエリの場合はそのハッシュを返す
+// メトリクスのラベルなど、クライアントが任意の値を送れると困る箇所で使う
+func RegisteredHash(ctx context.Context) (string, bool) {
+	if !graphql.HasOperationContext(ctx) {
+		return "", false
+	}
+	hash, ok := graphql.GetOperationContext(ctx).Stats.GetExtension(statsExtension).(string)
+	return hash, ok
+}
+
+// APQの拡張（extensions.persistedQuery.sha256Hash）で送られたハッシュ
+func requestedHash(rawParams *graphql.RawParams) string {
+	ext, _ := rawParams.Extensions["persistedQuery"].(map[string]interface{})
+	hash, _ := ext["sha256Hash"].(string)
+	return hash
+}
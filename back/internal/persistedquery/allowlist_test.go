@@ -0,0 +1,128 @@
+package persistedquery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const (
+	registeredQuery = "query WorkList { workList { id } }"
+	otherQuery      = "query Users { users { id } }"
+)
+
+func withHash(hash string) map[string]interface{} {
+	return map[string]interface{}{"persistedQuery": map[string]interface{}{"version": float64(1), "sha256Hash": hash}}
+}
+
+func TestAllowlist(t *testing.T) {
+	manifest := Manifest{Hash(registeredQuery): registeredQuery}
+
+	tests := []struct {
+		name    string
+		enforce bool
+		params  graphql.RawParams
+		// 実行されるクエリ
+		wantQuery string
+		// マニフェストのクエリとして記録されるか
+		wantRegistered bool
+		wantErr        bool
+	}{
+		{
+			name:           "fill: hash only",
+			params:         graphql.RawParams{Extensions: withHash(Hash(registeredQuery))},
+			wantQuery:      registeredQuery,
+			wantRegistered: true,
+		},
+		{
+			name:           "fill: registered query",
+			params:         graphql.RawParams{Query: registeredQuery},
+			wantQuery:      registeredQuery,
+			wantRegistered: true,
+		},
+		{
+			// マニフェストにないクエリはそのまま実行する
+			name:      "fill: unknown query",
+			params:    graphql.RawParams{Query: otherQuery},
+			wantQuery: otherQuery,
+		},
+		{
+			// APQのキャッシュで解決するため、ここでは補わない
+			name:   "fill: unknown hash",
+			params: graphql.RawParams{Extensions: withHash(Hash(otherQuery))},
+		},
+		{
+			name:           "enforce: hash only",
+			enforce:        true,
+			params:         graphql.RawParams{Extensions: withHash(Hash(registeredQuery))},
+			wantQuery:      registeredQuery,
+			wantRegistered: true,
+		},
+		{
+			name:           "enforce: registered query",
+			enforce:        true,
+			params:         graphql.RawParams{Query: registeredQuery},
+			wantQuery:      registeredQuery,
+			wantRegistered: true,
+		},
+		{
+			name:    "enforce: unknown query",
+			enforce: true,
+			params:  graphql.RawParams{Query: otherQuery},
+			wantErr: true,
+		},
+		{
+			name:    "enforce: unknown hash",
+			enforce: true,
+			params:  graphql.RawParams{Extensions: withHash(Hash(otherQuery))},
+			wantErr: true,
+		},
+		{
+			// 登録済みのハッシュと別のクエリを送っても、クエリのハッシュで判定する
+			name:    "enforce: registered hash with another query",
+			enforce: true,
+			params:  graphql.RawParams{Query: otherQuery, Extensions: withHash(Hash(registeredQuery))},
+			wantErr: true,
+		},
+		{
+			name:    "enforce: empty request",
+			enforce: true,
+			params:  graphql.RawParams{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{})
+			params := tt.params
+			gqlErr := Allowlist{Manifest: manifest, Enforce: tt.enforce}.MutateOperationParameters(ctx, &params)
+			if tt.wantErr {
+				if gqlErr == nil {
+					t.Fatal("err = nil, want the query to be rejected")
+				}
+				if gqlErr.Extensions["reason"] != "PERSISTED_QUERY_NOT_ALLOWED" {
+					t.Errorf("extensions = %v", gqlErr.Extensions)
+				}
+				return
+			}
+			if gqlErr != nil {
+				t.Fatal(gqlErr)
+			}
+			if params.Query != tt.wantQuery {
+				t.Errorf("query = %q, want %q", params.Query, tt.wantQuery)
+			}
+			hash, registered := RegisteredHash(ctx)
+			if registered != tt.wantRegistered || (registered && hash != Hash(registeredQuery)) {
+				t.Errorf("RegisteredHash() = (%q, %v), want registered %v", hash, registered, tt.wantRegistered)
+			}
+		})
+	}
+}
+
+func TestRegisteredHashWithoutOperation(t *testing.T) {
+	if _, ok := RegisteredHash(context.Background()); ok {
+		t.Error("RegisteredHash() = true outside an operation")
+	}
+}
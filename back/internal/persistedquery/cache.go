@@ -0,0 +1,46 @@
+package persistedquery
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redisのキーの接頭辞
+const keyPrefix = "hackmeet:apq:"
+
+// Automatic Persisted Queries（APQ）のクエリをRedisに保存するキャッシュ
+// サーバーの再起動や複数のインスタンスでも登録済みのクエリを使える
+// 取得されたクエリは有効期限を延長し、使われなくなったクエリだけが消える
+type Cache struct {
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+var _ graphql.Cache[string] = &Cache{}
+
+func NewCache(rdb *redis.Client, ttl time.Duration) *Cache {
+	return &Cache{rdb: rdb, ttl: ttl}
+}
+
+func (c *Cache) Get(ctx context.Context, hash string) (string, bool) {
+	query, err := c.rdb.GetEx(ctx, keyPrefix+hash, c.ttl).Result()
+	if err != nil {
+		// 見つからない・Redisの障害の場合は、クライアントがクエリ全体を送り直す
+		if !errors.Is(err, redis.Nil) {
+			slog.WarnContext(ctx, "failed to get persisted query", "hash", hash, "error", err)
+		}
+		return "", false
+	}
+	return query, true
+}
+
+func (c *Cache) Add(ctx context.Context, hash string, query string) {
+	if err := c.rdb.Set(ctx, keyPrefix+hash, query, c.ttl).Err(); err != nil {
+		slog.WarnContext(ctx, "failed to save persisted query", "hash", hash, "error", err)
+	}
+}
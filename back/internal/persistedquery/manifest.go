@@ -0,0 +1,37 @@
+package persistedquery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// フロントエンドのビルド時に生成するクエリの一覧（クエリのSHA-256 → クエリ）
+// front/scripts/persisted-queries.mjs が front/src/graph のオペレーションから生成する
+type Manifest map[string]string
+
+// マニフェストを読み込み、ハッシュとクエリが一致しているか確認する
+func LoadManifest(path string) (Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read persisted queries manifest: %w", err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse persisted queries manifest: %w", err)
+	}
+	for hash, query := range m {
+		if Hash(query) != hash {
+			return nil, fmt.Errorf("persisted queries manifest: hash %s does not match its query", hash)
+		}
+	}
+	return m, nil
+}
+
+// APQと同じ形式のクエリのハッシュ（SHA-256の16進数）
+func Hash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,60 @@
+package persistedquery
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// マニフェストを一時ディレクトリに書き出す
+func writeManifest(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "persisted_queries.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestHash(t *testing.T) {
+	// APQのクライアントと同じSHA-256の16進数
+	if got := Hash("{ __typename }"); got != "7f56e67dd21ab3f30d1ff8b7bed08893f0a0db86449836189b361dd1e56ddb4b" {
+		t.Errorf("Hash() = %s", got)
+	}
+}
+
+func TestLoadManifest(t *testing.T) {
+	query := "query WorkList { workList { id } }"
+	m, err := LoadManifest(writeManifest(t, `{"`+Hash(query)+`": "query WorkList { workList { id } }"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != 1 || m[Hash(query)] != query {
+		t.Errorf("manifest = %v", m)
+	}
+}
+
+func TestLoadManifestErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"hash mismatch", `{"` + Hash("query A { a }") + `": "query B { b }"}`, "does not match its query"},
+		{"not a hash", `{"WorkList": "query WorkList { workList { id } }"}`, "hash WorkList does not match"},
+		{"invalid json", `["query A { a }"]`, "failed to parse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadManifest(writeManifest(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := LoadManifest(filepath.Join(t.TempDir(), "missing.json")); err == nil || !strings.Contains(err.Error(), "failed to read") {
+		t.Errorf("err = %v, want a read error", err)
+	}
+}
@@ -0,0 +1,125 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redisのチャンネル名の接頭辞
+const channelPrefix = "hackmeet:"
+
+// 購読者ごとに溜めておけるメッセージ数（溢れた分は捨てる）
+const subscriberBuffer = 16
+
+// Redisのpub/subでサーバーの全インスタンスにイベントを配信する
+// Redisへの購読はインスタンスごとに1接続（PSUBSCRIBE hackmeet:*）だけ行い、受け取ったメッセージをプロセス内の購読者に配る
+type PubSub struct {
+	rdb *redis.Client
+
+	mu          sync.Mutex
+	sub         *redis.PubSub // 最初の購読で開始する
+	subscribers map[string]map[chan []byte]struct{}
+}
+
+func New(rdb *redis.Client) *PubSub {
+	return &PubSub{rdb: rdb, subscribers: map[string]map[chan []byte]struct{}{}}
+}
+
+// payloadをJSONにして配信する
+func (p *PubSub) Publish(ctx context.Context, topic string, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return p.rdb.Publish(ctx, channelPrefix+topic, b).Err()
+}
+
+// topicを購読し、受け取ったメッセージを返す
+// ctxが終了すると購読を解除してチャンネルを閉じる
+func (p *PubSub) Subscribe(ctx context.Context, topic string) (<-chan []byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.listen(ctx); err != nil {
+		return nil, err
+	}
+
+	out := make(chan []byte, subscriberBuffer)
+	if p.subscribers[topic] == nil {
+		p.subscribers[topic] = map[chan []byte]struct{}{}
+	}
+	p.subscribers[topic][out] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		delete(p.subscribers[topic], out)
+		if len(p.subscribers[topic]) == 0 {
+			delete(p.subscribers, topic)
+		}
+		close(out)
+	}()
+	return out, nil
+}
+
+// Redisへの購読を開始する（p.muを持った状態で呼ぶ）
+// 接続が切れた場合はgo-redisが再接続して購読し直す
+func (p *PubSub) listen(ctx context.Context) error {
+	if p.sub != nil {
+		return nil
+	}
+	sub := p.rdb.PSubscribe(context.Background(), channelPrefix+"*")
+	// 購読が確定する前に配信されたメッセージを取りこぼさないよう、応答を待つ
+	if _, err := sub.Receive(ctx); err != nil {
+		sub.Close()
+		return err
+	}
+	p.sub = sub
+	go p.dispatch(sub.Channel())
+	return nil
+}
+
+// 受け取ったメッセージをtopicの購読者に配る
+// 遅い購読者が他の購読者を止めないよう、バッファが一杯の購読者には送らない
+func (p *PubSub) dispatch(messages <-chan *redis.Message) {
+	for msg := range messages {
+		topic := strings.TrimPrefix(msg.Channel, channelPrefix)
+		p.mu.Lock()
+		for out := range p.subscribers[topic] {
+			select {
+			case out <- []byte(msg.Payload):
+			default:
+				slog.Warn("dropped event for a slow subscriber", "topic", topic)
+			}
+		}
+		p.mu.Unlock()
+	}
+}
+
+// Redisへの購読を終了する
+// 購読中のチャンネルは各購読のctxが終了したときに閉じる
+func (p *PubSub) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.sub == nil {
+		return nil
+	}
+	err := p.sub.Close()
+	p.sub = nil
+	return err
+}
+
+// 配信に失敗しても元の処理は成功させるため、エラーはログに残すだけにする
+func (p *PubSub) PublishOrLog(ctx context.Context, topic string, payload any) {
+	if p == nil {
+		return
+	}
+	if err := p.Publish(ctx, topic, payload); err != nil {
+		slog.ErrorContext(ctx, "failed to publish event", "topic", topic, "error", err)
+	}
+}
@@ -0,0 +1,129 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestPubSub(t *testing.T) (*PubSub, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	p := New(rdb)
+	t.Cleanup(func() { p.Close() })
+	return p, mr
+}
+
+func receive(t *testing.T, ch <-chan []byte) string {
+	t.Helper()
+	select {
+	case b, ok := <-ch:
+		if !ok {
+			t.Fatal("channel closed")
+		}
+		return string(b)
+	case <-time.After(time.Second):
+		t.Fatal("no message received")
+		return ""
+	}
+}
+
+func TestSubscribeSharesOneConnection(t *testing.T) {
+	p, mr := newTestPubSub(t)
+	ctx := context.Background()
+
+	a, err := p.Subscribe(ctx, "card_scanned:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := p.Subscribe(ctx, "card_scanned:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := p.Subscribe(ctx, "card_scanned:2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 購読の数に関わらず、Redisへの購読はパターン1つだけ
+	if n := mr.PubSubNumPat(); n != 1 {
+		t.Errorf("pattern subscriptions = %d, want 1", n)
+	}
+
+	if err := p.Publish(ctx, "card_scanned:1", map[string]string{"id": "1"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range []<-chan []byte{a, b} {
+		if got := receive(t, ch); got != `{"id":"1"}` {
+			t.Errorf("message = %s", got)
+		}
+	}
+	// 他のトピックの購読者には届かない
+	select {
+	case msg := <-other:
+		t.Errorf("unexpected message %s", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSubscribeUnsubscribesOnCancel(t *testing.T) {
+	p, _ := newTestPubSub(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch, err := p.Subscribe(ctx, "profile_updated:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Fatal("received a message after cancel")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.subscribers) != 0 {
+		t.Errorf("subscribers = %v, want none", p.subscribers)
+	}
+}
+
+// 遅い購読者がいても他の購読者への配信は止まらない
+func TestSlowSubscriberDoesNotBlockOthers(t *testing.T) {
+	p, _ := newTestPubSub(t)
+	ctx := context.Background()
+
+	if _, err := p.Subscribe(ctx, "work_added_to_event:1"); err != nil {
+		t.Fatal(err)
+	}
+	fast, err := p.Subscribe(ctx, "work_added_to_event:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < subscriberBuffer+5; i++ {
+		if err := p.Publish(ctx, "work_added_to_event:1", i); err != nil {
+			t.Fatal(err)
+		}
+		receive(t, fast)
+	}
+}
+
+func TestSubscribeRedisUnavailable(t *testing.T) {
+	p, mr := newTestPubSub(t)
+	mr.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := p.Subscribe(ctx, "card_scanned:1"); err == nil {
+		t.Fatal("Subscribe() = nil, want an error")
+	}
+}
@@ -0,0 +1,74 @@
+package querylimit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const CodeDepthLimitExceeded = "DEPTH_LIMIT_EXCEEDED"
+
+// フィールドのネストが上限を超える操作を実行前に拒否するgqlgenの拡張
+// イントロスペクション（__schemaなど）のフィールドは数えない
+type Depth struct {
+	Max int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = Depth{}
+
+func (Depth) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d Depth) Validate(graphql.ExecutableSchema) error {
+	if d.Max < 1 {
+		return fmt.Errorf("depth limit must be positive: %d", d.Max)
+	}
+	return nil
+}
+
+func (d Depth) MutateOperationContext(ctx context.Context, oc *graphql.OperationContext) *gqlerror.Error {
+	if oc.Operation == nil {
+		return nil
+	}
+	depth := selectionDepth(oc.Operation.SelectionSet, map[string]bool{})
+	if depth <= d.Max {
+		return nil
+	}
+	return apperror.New(CodeDepthLimitExceeded, "クエリのネストが深すぎます（%d階層、上限は%d階層）。", depth, d.Max).GQLError()
+}
+
+// 選択セットの最も深いフィールドまでの階層数
+// visitingで展開中のフラグメントを記録し、循環参照で無限に再帰しないようにする
+func selectionDepth(set ast.SelectionSet, visiting map[string]bool) int {
+	depth := 0
+	for _, sel := range set {
+		var d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+			d = 1 + selectionDepth(sel.SelectionSet, visiting)
+		case *ast.InlineFragment:
+			d = selectionDepth(sel.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			if sel.Definition == nil || visiting[sel.Name] {
+				continue
+			}
+			visiting[sel.Name] = true
+			d = selectionDepth(sel.Definition.SelectionSet, visiting)
+			delete(visiting, sel.Name)
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
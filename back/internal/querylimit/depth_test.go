@@ -0,0 +1,75 @@
+package querylimit
+
+import (
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// クエリを解析し、フラグメントの展開に定義を結びつける
+// 循環するフラグメントはスキーマの検証で弾かれるため、検証を通さずに結びつける
+func parseOperation(t *testing.T, query string) *ast.OperationDefinition {
+	t.Helper()
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var link func(ast.SelectionSet)
+	link = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				link(sel.SelectionSet)
+			case *ast.InlineFragment:
+				link(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				sel.Definition = doc.Fragments.ForName(sel.Name)
+			}
+		}
+	}
+	for _, op := range doc.Operations {
+		link(op.SelectionSet)
+	}
+	for _, f := range doc.Fragments {
+		link(f.SelectionSet)
+	}
+	return doc.Operations[0]
+}
+
+func TestSelectionDepth(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{"nested fields", `{ a { b { c } } }`, 3},
+		{"introspection is not counted", `{ __schema { types { fields { name } } } a }`, 1},
+		{"typename is not counted", `{ a { __typename } }`, 1},
+		{"inline fragment adds no depth", `{ a { ... on T { b { c } } } }`, 3},
+		{"fragment spread", `{ a { ...F } } fragment F on T { b { c } }`, 3},
+		{"self-referencing fragment", `{ a { ...F } } fragment F on T { b { ...F } }`, 2},
+		{
+			"mutually recursive fragments",
+			`{ a { ...F } } fragment F on T { b { ...G } } fragment G on T { c { ...F } }`,
+			3,
+		},
+		{
+			"cycle inside inline fragment",
+			`{ a { ...F } } fragment F on T { ... on T { b { ...F } } }`,
+			2,
+		},
+		// 同じフラグメントを別の場所で使う場合は、それぞれの深さで数える
+		{"fragment reused at different depths", `{ a { ...F } d { e { ...F } } } fragment F on T { b }`, 3},
+		{"undefined fragment", `{ a { ...Missing } }`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := parseOperation(t, tt.query)
+			if got := selectionDepth(op.SelectionSet, map[string]bool{}); got != tt.want {
+				t.Errorf("selectionDepth() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("172.16.0.0/12")
+	trusted := []*net.IPNet{proxies}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		realIP     string
+		forwarded  string
+		want       string
+	}{
+		{name: "direct", remoteAddr: "198.51.100.1:1234", want: "198.51.100.1"},
+		{name: "spoofed real ip from untrusted", remoteAddr: "198.51.100.1:1234", realIP: "203.0.113.9", want: "198.51.100.1"},
+		{name: "spoofed forwarded from untrusted", remoteAddr: "198.51.100.1:1234", forwarded: "203.0.113.9", want: "198.51.100.1"},
+		{name: "real ip from proxy", remoteAddr: "172.18.0.5:1234", realIP: "203.0.113.9", want: "203.0.113.9"},
+		{name: "forwarded from proxy", remoteAddr: "172.18.0.5:1234", forwarded: "203.0.113.9", realIP: "203.0.113.9", want: "203.0.113.9"},
+		{name: "client prepended a fake hop", remoteAddr: "172.18.0.5:1234", forwarded: "10.0.0.1, 203.0.113.9", want: "203.0.113.9"},
+		{name: "chained proxies", remoteAddr: "172.18.0.5:1234", forwarded: "203.0.113.9, 172.18.0.7", want: "203.0.113.9"},
+		{name: "invalid header from proxy", remoteAddr: "172.18.0.5:1234", realIP: "not-an-ip", want: "172.18.0.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if got := resolveClientIP(r, trusted); got != tt.want {
+				t.Errorf("resolveClientIP() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// window内にlimit回までリクエストを許可する
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// ginのルート（c.FullPath()の形式、例: /api/v1/auth/:provider/callback）ごとの制限
+type RouteRule struct {
+	Path string
+	Rule
+}
+
+// GraphQLの操作名（"*"は全ての操作）またはフィールド（型名.フィールド名）ごとの制限
+type GraphQLRule struct {
+	Operation string
+	Field     string
+	Rule
+}
+
+type Config struct {
+	Routes  []RouteRule
+	GraphQL []GraphQLRule
+}
+
+type ruleFile struct {
+	Limit  int    `yaml:"limit"`
+	Window string `yaml:"window"`
+}
+
+type configFile struct {
+	Routes []struct {
+		Path     string `yaml:"path"`
+		ruleFile `yaml:",inline"`
+	} `yaml:"routes"`
+	GraphQL []struct {
+		Operation string `yaml:"operation"`
+		Field     string `yaml:"field"`
+		ruleFile  `yaml:",inline"`
+	} `yaml:"graphql"`
+}
+
+// レート制限の設定ファイルを読み込む（誤りはまとめて返す）
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rate limits file: %w", err)
+	}
+
+	var file configFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse rate limits file: %w", err)
+	}
+
+	config := &Config{}
+	var errs []error
+	paths := make(map[string]bool)
+	for i, r := range file.Routes {
+		rule, err := r.ruleFile.parse()
+		if r.Path == "" || !strings.HasPrefix(r.Path, "/") {
+			err = errors.Join(err, fmt.Errorf("path %q must start with /", r.Path))
+		} else if paths[r.Path] {
+			err = errors.Join(err, fmt.Errorf("duplicate path %q", r.Path))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("routes[%d]: %w", i, err))
+			continue
+		}
+		paths[r.Path] = true
+		config.Routes = append(config.Routes, RouteRule{Path: r.Path, Rule: rule})
+	}
+
+	targets := make(map[string]bool)
+	for i, g := range file.GraphQL {
+		rule, err := g.ruleFile.parse()
+		target := "operation:" + g.Operation
+		switch {
+		case (g.Operation == "") == (g.Field == ""):
+			err = errors.Join(err, errors.New("either operation or field is required"))
+		case g.Field != "":
+			target = "field:" + g.Field
+			if typeName, fieldName, ok := strings.Cut(g.Field, "."); !ok || typeName == "" || fieldName == "" {
+				err = errors.Join(err, fmt.Errorf("field %q must be in the form Type.field", g.Field))
+			}
+		}
+		if err == nil && targets[target] {
+			err = fmt.Errorf("duplicate %s", target)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("graphql[%d]: %w", i, err))
+			continue
+		}
+		targets[target] = true
+		config.GraphQL = append(config.GraphQL, GraphQLRule{Operation: g.Operation, Field: g.Field, Rule: rule})
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (r ruleFile) parse() (Rule, error) {
+	var errs []error
+	if r.Limit <= 0 {
+		errs = append(errs, errors.New("limit must be greater than 0"))
+	}
+	window, err := time.ParseDuration(r.Window)
+	if err != nil || window <= 0 {
+		errs = append(errs, fmt.Errorf("window %q must be a positive duration such as 1m", r.Window))
+	}
+	return Rule{Limit: r.Limit, Window: window}, errors.Join(errs...)
+}
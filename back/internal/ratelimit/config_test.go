@@ -0,0 +1,151 @@
+package ratelimit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 設定ファイルを一時ディレクトリに書き出す
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rate_limits.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig(writeConfigFile(t, `
+routes:
+  - {path: /api/v1/auth/refresh, limit: 10, window: 1m}
+graphql:
+  - {operation: "*", limit: 300, window: 1m}
+  - {field: Query.workList, limit: 30, window: 30s}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Routes) != 1 || config.Routes[0] != (RouteRule{Path: "/api/v1/auth/refresh", Rule: Rule{Limit: 10, Window: time.Minute}}) {
+		t.Errorf("routes = %+v", config.Routes)
+	}
+	if len(config.GraphQL) != 2 || config.GraphQL[1] != (GraphQLRule{Field: "Query.workList", Rule: Rule{Limit: 30, Window: 30 * time.Second}}) {
+		t.Errorf("graphql = %+v", config.GraphQL)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		// エラーに含まれる文言（全て含むこと）
+		wantErr []string
+	}{
+		{
+			name:    "zero limit",
+			content: `routes: [{path: /a, limit: 0, window: 1m}]`,
+			wantErr: []string{"routes[0]: limit must be greater than 0"},
+		},
+		{
+			name:    "invalid window",
+			content: `routes: [{path: /a, limit: 1, window: soon}]`,
+			wantErr: []string{`routes[0]: window "soon" must be a positive duration`},
+		},
+		{
+			name:    "negative window",
+			content: `graphql: [{operation: "*", limit: 1, window: -1m}]`,
+			wantErr: []string{`graphql[0]: window "-1m" must be a positive duration`},
+		},
+		{
+			name:    "missing window",
+			content: `routes: [{path: /a, limit: 1}]`,
+			wantErr: []string{`window "" must be a positive duration`},
+		},
+		{
+			name:    "relative path",
+			content: `routes: [{path: api/v1/auth/refresh, limit: 1, window: 1m}]`,
+			wantErr: []string{`path "api/v1/auth/refresh" must start with /`},
+		},
+		{
+			name:    "duplicate path",
+			content: `routes: [{path: /a, limit: 1, window: 1m}, {path: /a, limit: 2, window: 1m}]`,
+			wantErr: []string{`routes[1]: duplicate path "/a"`},
+		},
+		{
+			name:    "operation and field",
+			content: `graphql: [{operation: A, field: Query.a, limit: 1, window: 1m}]`,
+			wantErr: []string{"either operation or field is required"},
+		},
+		{
+			name:    "invalid field",
+			content: `graphql: [{field: workList, limit: 1, window: 1m}]`,
+			wantErr: []string{`field "workList" must be in the form Type.field`},
+		},
+		{
+			name:    "duplicate field",
+			content: `graphql: [{field: Query.a, limit: 1, window: 1m}, {field: Query.a, limit: 1, window: 1m}]`,
+			wantErr: []string{"graphql[1]: duplicate field:Query.a"},
+		},
+		{
+			name: "every error is reported",
+			content: `
+routes: [{path: /a, limit: 0, window: 0s}]
+graphql: [{limit: 1, window: 1m}]
+`,
+			wantErr: []string{"limit must be greater than 0", `window "0s"`, "graphql[0]: either operation or field is required"},
+		},
+		{
+			name:    "invalid yaml",
+			content: `routes: {`,
+			wantErr: []string{"failed to parse rate limits file"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadConfig(writeConfigFile(t, tt.content))
+			if err == nil {
+				t.Fatalf("err = nil, want %q", tt.wantErr)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("err = %q, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadConfigMissingFile(t *testing.T) {
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.yaml")); err == nil || !strings.Contains(err.Error(), "failed to read") {
+		t.Errorf("err = %v, want a read error", err)
+	}
+}
+
+func TestValidateRoutes(t *testing.T) {
+	routes := gin.RoutesInfo{
+		{Method: "GET", Path: "/api/v1/auth/:provider"},
+		{Method: "POST", Path: "/api/v1/auth/refresh"},
+	}
+	rules := []RouteRule{
+		{Path: "/api/v1/auth/:provider"},
+		{Path: "/api/v1/auth/refresh"},
+	}
+	if err := ValidateRoutes(rules, routes); err != nil {
+		t.Errorf("ValidateRoutes() = %v", err)
+	}
+
+	// ginのルートの形式でないパスは一致しない
+	rules = append(rules, RouteRule{Path: "/api/v1/auth/google"}, RouteRule{Path: "/api/v1/unknown"})
+	err := ValidateRoutes(rules, routes)
+	for _, want := range []string{`"/api/v1/auth/google"`, `"/api/v1/unknown"`} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("err = %v, want it to report %s", err, want)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/noonyuu/nfc/back/internal/apperror"
+)
+
+// GraphQLの操作・フィールドごとに制限するgqlgenの拡張
+// HTTPのステータスとRetry-Afterヘッダーを設定するため、Middlewareと併せて使う
+type GraphQL struct {
+	limiter    *Limiter
+	operations map[string]Rule
+	fields     map[string]Rule
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+} = &GraphQL{}
+
+func NewGraphQL(limiter *Limiter, rules []GraphQLRule) *GraphQL {
+	g := &GraphQL{
+		limiter:    limiter,
+		operations: make(map[string]Rule),
+		fields:     make(map[string]Rule),
+	}
+	for _, r := range rules {
+		if r.Field != "" {
+			g.fields[r.Field] = r.Rule
+		} else {
+			g.operations[r.Operation] = r.Rule
+		}
+	}
+	return g
+}
+
+func (g *GraphQL) ExtensionName() string {
+	return "RateLimit"
+}
+
+// 設定されたフィールドがスキーマに存在するか確認する
+func (g *GraphQL) Validate(schema graphql.ExecutableSchema) error {
+	var errs []error
+	for field := range g.fields {
+		typeName, fieldName, _ := strings.Cut(field, ".")
+		def := schema.Schema().Types[typeName]
+		if def == nil || def.Fields.ForName(fieldName) == nil {
+			errs = append(errs, fmt.Errorf("rate limit field %q is not defined in the schema", field))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// 操作名ごとの制限（"*"は全ての操作で共有する）
+func (g *GraphQL) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	state := stateFromContext(ctx)
+	if state == nil {
+		return next(ctx)
+	}
+
+	oc := graphql.GetOperationContext(ctx)
+	for _, name := range []string{"*", oc.OperationName} {
+		rule, ok := g.operations[name]
+		if !ok || name == "" {
+			continue
+		}
+		result := g.limiter.allow(ctx, "operation:"+name+":"+state.subject, rule)
+		if !result.Allowed {
+			state.reject(result.RetryAfter)
+			return apperror.Reject(ctx, rateLimited(result.RetryAfter))
+		}
+	}
+
+	return next(context.WithValue(ctx, fieldResultsKey{}, &sync.Map{}))
+}
+
+// フィールドごとの制限（1回の操作内で同じフィールドが複数回解決されても1回として数える）
+func (g *GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Field == nil {
+		return next(ctx)
+	}
+	field := fc.Object + "." + fc.Field.Name
+	rule, ok := g.fields[field]
+	state := stateFromContext(ctx)
+	results, _ := ctx.Value(fieldResultsKey{}).(*sync.Map)
+	if !ok || state == nil || results == nil {
+		return next(ctx)
+	}
+
+	value, _ := results.LoadOrStore(field, sync.OnceValue(func() Result {
+		return g.limiter.allow(ctx, "field:"+field+":"+state.subject, rule)
+	}))
+	if result := value.(func() Result)(); !result.Allowed {
+		state.setRetryAfter(result.RetryAfter)
+		return nil, rateLimited(result.RetryAfter)
+	}
+	return next(ctx)
+}
+
+func rateLimited(retryAfter time.Duration) *apperror.Error {
+	return apperror.New(CodeRateLimited, "リクエストが多すぎます。しばらくしてから再度お試しください。").
+		With("retryAfter", RetryAfterSeconds(retryAfter))
+}
+
+type fieldResultsKey struct{}
+
+type stateKey struct{}
+
+// 1リクエストの制限の状態（レスポンスのヘッダーを書き込む前に設定する）
+type requestState struct {
+	subject string
+
+	mu         sync.Mutex
+	rejected   bool
+	retryAfter time.Duration
+}
+
+func stateFromContext(ctx context.Context) *requestState {
+	state, _ := ctx.Value(stateKey{}).(*requestState)
+	return state
+}
+
+func (s *requestState) reject(retryAfter time.Duration) {
+	s.mu.Lock()
+	s.rejected = true
+	s.mu.Unlock()
+	s.setRetryAfter(retryAfter)
+}
+
+func (s *requestState) setRetryAfter(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d > s.retryAfter {
+		s.retryAfter = d
+	}
+}
+
+// GraphQLのリクエストに制限の対象を設定し、制限された場合は429とRetry-Afterを返すミドルウェア
+// 認証のミドルウェアの後に置く
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := &requestState{subject: subject(r)}
+		ctx := context.WithValue(r.Context(), stateKey{}, state)
+		next.ServeHTTP(&responseWriter{ResponseWriter: w, state: state}, r.WithContext(ctx))
+	})
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	state       *requestState
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	w.state.mu.Lock()
+	rejected, retryAfter := w.state.rejected, w.state.retryAfter
+	w.state.mu.Unlock()
+
+	if retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(RetryAfterSeconds(retryAfter)))
+	}
+	// 操作全体を拒否した場合のみ429にする（フィールド単位の場合は他のフィールドの結果を返す）
+	if rejected && status == http.StatusOK {
+		status = http.StatusTooManyRequests
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// WebSocketへのアップグレードのためHijackを委譲する
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
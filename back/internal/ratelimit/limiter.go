@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redisのキーの接頭辞
+const keyPrefix = "ratelimit:"
+
+// スライディングウィンドウ（直近windowのリクエスト時刻をソート済みセットで保持する）
+// 戻り値: {許可したか, 残り回数, 再試行までのミリ秒}
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+if count < limit then
+  redis.call('ZADD', key, now, ARGV[4])
+  redis.call('PEXPIRE', key, window)
+  return {1, limit - count - 1, 0}
+end
+
+local retry = window
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+  retry = tonumber(oldest[2]) + window - now
+end
+return {0, 0, retry}
+`)
+
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+type Limiter struct {
+	client *redis.Client
+}
+
+func NewLimiter(client *redis.Client) *Limiter {
+	return &Limiter{client: client}
+}
+
+// keyのリクエストを1回数え、ruleの範囲内かを返す
+func (l *Limiter) Allow(ctx context.Context, key string, rule Rule) (Result, error) {
+	member, err := uuid.NewRandom()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to generate request id: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	values, err := slidingWindowScript.Run(ctx, l.client,
+		[]string{keyPrefix + key},
+		now,
+		rule.Window.Milliseconds(),
+		rule.Limit,
+		strconv.FormatInt(now, 10)+":"+member.String(),
+	).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to run rate limit script: %w", err)
+	}
+	if len(values) != 3 {
+		return Result{}, fmt.Errorf("unexpected rate limit script result: %v", values)
+	}
+
+	return Result{
+		Allowed:    values[0] == 1,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
+
+// Retry-Afterヘッダーの値（秒、切り上げ）
+func RetryAfterSeconds(d time.Duration) int {
+	seconds := int((d + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
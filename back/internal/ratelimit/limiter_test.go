@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestLimiter(t *testing.T) (*Limiter, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return NewLimiter(rdb), mr
+}
+
+func TestLimiterAllow(t *testing.T) {
+	l, _ := newTestLimiter(t)
+	ctx := context.Background()
+	rule := Rule{Limit: 3, Window: 300 * time.Millisecond}
+
+	for i := 0; i < rule.Limit; i++ {
+		result, err := l.Allow(ctx, "test:a", rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.Allowed || result.Remaining != rule.Limit-i-1 {
+			t.Fatalf("request %d = %+v, want allowed with %d remaining", i+1, result, rule.Limit-i-1)
+		}
+	}
+
+	// limitを超えたら、最も古いリクエストがwindowから外れるまで拒否する
+	result, err := l.Allow(ctx, "test:a", rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Allowed || result.RetryAfter <= 0 || result.RetryAfter > rule.Window {
+		t.Fatalf("over limit = %+v, want rejected with 0 < retry after <= %s", result, rule.Window)
+	}
+
+	// キーごとに数える
+	if result, err := l.Allow(ctx, "test:b", rule); err != nil || !result.Allowed {
+		t.Fatalf("other key = %+v, %v, want allowed", result, err)
+	}
+
+	// windowが過ぎれば再び許可する
+	time.Sleep(result.RetryAfter + 50*time.Millisecond)
+	result, err = l.Allow(ctx, "test:a", rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Allowed {
+		t.Fatalf("after window = %+v, want allowed", result)
+	}
+}
+
+// 拒否されたリクエストは数えない
+func TestLimiterDoesNotCountRejected(t *testing.T) {
+	l, mr := newTestLimiter(t)
+	ctx := context.Background()
+	rule := Rule{Limit: 1, Window: time.Minute}
+
+	for i := 0; i < 3; i++ {
+		if _, err := l.Allow(ctx, "test:a", rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+	members, err := mr.ZMembers(keyPrefix + "test:a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 {
+		t.Errorf("counted %d requests, want 1", len(members))
+	}
+}
+
+// Redisに接続できない場合は許可する
+func TestLimiterFailsOpen(t *testing.T) {
+	l, mr := newTestLimiter(t)
+	mr.Close()
+
+	if _, err := l.Allow(context.Background(), "test:a", Rule{Limit: 1, Window: time.Minute}); err == nil {
+		t.Fatal("Allow() = nil, want an error")
+	}
+	if result := l.allow(context.Background(), "test:a", Rule{Limit: 1, Window: time.Minute}); !result.Allowed {
+		t.Errorf("allow() = %+v, want allowed", result)
+	}
+}
+
+func TestGin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	l, mr := newTestLimiter(t)
+
+	r := gin.New()
+	r.Use(Gin(l, []RouteRule{{Path: "/limited/:id", Rule: Rule{Limit: 1, Window: time.Minute}}}))
+	r.GET("/limited/:id", func(c *gin.Context) { c.Status(http.StatusOK) })
+	r.GET("/free", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	request := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", path, nil)
+		req.RemoteAddr = "198.51.100.1:1234"
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	if w := request("/limited/1"); w.Code != http.StatusOK {
+		t.Fatalf("first request = %d", w.Code)
+	}
+	// パラメーターが違っても同じルートとして数える
+	w := request("/limited/2")
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") == "" {
+		t.Fatalf("second request = %d, Retry-After %q, want 429 with Retry-After", w.Code, w.Header().Get("Retry-After"))
+	}
+	if w := request("/free"); w.Code != http.StatusOK {
+		t.Errorf("unlimited route = %d", w.Code)
+	}
+
+	// Redisが止まっても制限のあるルートは使える
+	mr.Close()
+	if w := request("/limited/3"); w.Code != http.StatusOK {
+		t.Errorf("request without redis = %d, want 200", w.Code)
+	}
+}
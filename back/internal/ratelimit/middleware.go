@@ -0,0 +1,76 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/noonyuu/nfc/back/internal/auth"
+)
+
+// 制限を超えた場合のエラーコード
+const CodeRateLimited = "RATE_LIMITED"
+
+// 制限の対象（ログイン中はユーザーID、未ログインはIPアドレス）
+func subject(r *http.Request) string {
+	if viewer := auth.ViewerFromContext(r.Context()); viewer != nil {
+		return "user:" + viewer.UserID
+	}
+	return "ip:" + ClientIP(r)
+}
+
+// Redisに接続できない場合はリクエストを止めずに許可する
+func (l *Limiter) allow(ctx context.Context, key string, rule Rule) Result {
+	result, err := l.Allow(ctx, key, rule)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check rate limit", "error", err)
+		return Result{Allowed: true}
+	}
+	return result
+}
+
+// ginのルートごとに制限するミドルウェア（設定の無いルートは制限しない）
+func Gin(limiter *Limiter, rules []RouteRule) gin.HandlerFunc {
+	byPath := make(map[string]Rule, len(rules))
+	for _, r := range rules {
+		byPath[r.Path] = r.Rule
+	}
+
+	return func(c *gin.Context) {
+		rule, ok := byPath[c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		result := limiter.allow(c.Request.Context(), "route:"+c.FullPath()+":"+subject(c.Request), rule)
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(RetryAfterSeconds(result.RetryAfter)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": "Too many requests",
+				"code":  CodeRateLimited,
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// 設定されたパスがginのルートに存在するか確認する（存在しないパスは制限されないため）
+func ValidateRoutes(rules []RouteRule, routes gin.RoutesInfo) error {
+	registered := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		registered[r.Path] = true
+	}
+	var errs []error
+	for _, r := range rules {
+		if !registered[r.Path] {
+			errs = append(errs, fmt.Errorf("rate limit path %q is not a registered route", r.Path))
+		}
+	}
+	return errors.Join(errs...)
+}
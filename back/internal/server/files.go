@@ -0,0 +1,45 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/config"
+	"github.com/noonyuu/nfc/back/internal/persistedquery"
+	"github.com/noonyuu/nfc/back/internal/ratelimit"
+)
+
+// 設定で指定されたファイルの内容
+type Files struct {
+	RateLimits *ratelimit.Config
+	Providers  []auth.ProviderConfig
+	// クエリのマニフェスト（PERSISTED_QUERIES_FILEが未設定の場合はnil）
+	Manifest persistedquery.Manifest
+}
+
+// config.Loadに渡し、環境変数の誤りと併せて読み込みの誤りを報告する
+func (f *Files) Loaders() []config.FileLoader {
+	return []config.FileLoader{
+		func(cfg *config.Config) (err error) {
+			if f.RateLimits, err = ratelimit.LoadConfig(cfg.RateLimitsFile); err != nil {
+				return fmt.Errorf("RATE_LIMITS_FILE: %w", err)
+			}
+			return nil
+		},
+		func(cfg *config.Config) (err error) {
+			if f.Providers, err = auth.LoadProviderConfigs(cfg.AuthProvidersFile); err != nil {
+				return fmt.Errorf("AUTH_PROVIDERS_FILE: %w", err)
+			}
+			return nil
+		},
+		func(cfg *config.Config) (err error) {
+			if cfg.GraphQL.PersistedQueriesFile == "" {
+				return nil
+			}
+			if f.Manifest, err = persistedquery.LoadManifest(cfg.GraphQL.PersistedQueriesFile); err != nil {
+				return fmt.Errorf("PERSISTED_QUERIES_FILE: %w", err)
+			}
+			return nil
+		},
+	}
+}
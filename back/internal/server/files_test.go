@@ -0,0 +1,32 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/noonyuu/nfc/back/internal/config"
+)
+
+// 全ての設定ファイルの誤りをまとめて報告する
+func TestFilesLoadersReportEveryFile(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.Config{
+		RateLimitsFile:    dir + "/rate_limits.yaml",
+		AuthProvidersFile: dir + "/auth_providers.yaml",
+		GraphQL:           config.GraphQLConfig{PersistedQueriesFile: dir + "/persisted_queries.json"},
+	}
+
+	var files Files
+	var errs []string
+	for _, load := range files.Loaders() {
+		if err := load(cfg); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	joined := strings.Join(errs, "\n")
+	for _, key := range []string{"RATE_LIMITS_FILE:", "AUTH_PROVIDERS_FILE:", "PERSISTED_QUERIES_FILE:"} {
+		if !strings.Contains(joined, key) {
+			t.Errorf("errors do not report %s\n%s", key, joined)
+		}
+	}
+}
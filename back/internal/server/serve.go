@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/noonyuu/nfc/back/internal/config"
+	"github.com/noonyuu/nfc/back/internal/metrics"
+)
+
+// タイムアウトを設定したHTTPサーバーを起動する
+// ctxがキャンセルされたら新しい接続の受付を止め、処理中のリクエストの完了を待って終了する
+// MetricsAddrが設定されている場合は、Prometheusのメトリクスを別のリスナー（内部ネットワーク用）で公開する
+func Serve(ctx context.Context, cfg *config.Config, handler http.Handler) error {
+	servers := []*http.Server{{
+		Addr:              cfg.Addr,
+		Handler:           handler,
+		ReadTimeout:       cfg.Server.ReadTimeout,
+		ReadHeaderTimeout: cfg.Server.ReadTimeout,
+		WriteTimeout:      cfg.Server.WriteTimeout,
+		IdleTimeout:       cfg.Server.IdleTimeout,
+	}}
+	if cfg.Server.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metrics.Handler())
+		servers = append(servers, &http.Server{
+			Addr:              cfg.Server.MetricsAddr,
+			Handler:           metricsMux,
+			ReadHeaderTimeout: cfg.Server.ReadTimeout,
+		})
+	}
+
+	errCh := make(chan error, len(servers))
+	for _, srv := range servers {
+		go func() {
+			slog.Info("listening", "addr", srv.Addr)
+			errCh <- srv.ListenAndServe()
+		}()
+	}
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	for _, srv := range servers {
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			return err
+		}
+	}
+	for range servers {
+		if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,31 +1,53 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
-	"os"
+	"strings"
+	"time"
 
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/websocket"
 	"github.com/jmoiron/sqlx"
 	"github.com/noonyuu/nfc/back/graph"
+	"github.com/noonyuu/nfc/back/graph/directive"
+	"github.com/noonyuu/nfc/back/graph/loader"
 	"github.com/noonyuu/nfc/back/graph/resolver"
+	"github.com/noonyuu/nfc/back/internal/apperror"
 	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/config"
+	"github.com/noonyuu/nfc/back/internal/csrf"
+	"github.com/noonyuu/nfc/back/internal/health"
 	"github.com/noonyuu/nfc/back/internal/infrastructure/persistence"
 	"github.com/noonyuu/nfc/back/internal/interfaces"
 	handlerInterface "github.com/noonyuu/nfc/back/internal/interfaces/handler"
+	"github.com/noonyuu/nfc/back/internal/logger"
+	"github.com/noonyuu/nfc/back/internal/metrics"
+	"github.com/noonyuu/nfc/back/internal/persistedquery"
+	"github.com/noonyuu/nfc/back/internal/pubsub"
+	"github.com/noonyuu/nfc/back/internal/querylimit"
+	"github.com/noonyuu/nfc/back/internal/ratelimit"
+	"github.com/noonyuu/nfc/back/internal/tracing"
 	"github.com/noonyuu/nfc/back/internal/usecase"
 	"github.com/redis/go-redis/v9"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
-func NewRouter(dbMysql *sqlx.DB, dbRedis *redis.Client, graphql *resolver.Resolver) http.Handler {
+// filesはconfig.Loadで読み込んだ設定ファイル
+func NewRouter(cfg *config.Config, files *Files, dbMysql *sqlx.DB, dbRedis *redis.Client, graphql *resolver.Resolver, jwtKeys *config.KeySet) (http.Handler, error) {
 	mux := http.NewServeMux()
-	hostUrl := os.Getenv("HOST_URL")
+	hostOrigin := cfg.HostOrigin()
 
-	auth.NewAuth()
+	if err := auth.NewAuth(cfg, files.Providers); err != nil {
+		return nil, err
+	}
 
 	// User依存関係の注入
 	userPersistence := persistence.NewUserPersistence(dbMysql)
@@ -33,22 +55,57 @@ func NewRouter(dbMysql *sqlx.DB, dbRedis *redis.Client, graphql *resolver.Resolv
 
 	// Session依存関係の注入
 	sessionPersistence := persistence.NewRedisSessionRepository(dbRedis)
-	sessionUseCase := usecase.NewSessionUseCase(sessionPersistence)
+	sessionUseCase := usecase.NewSessionUseCase(sessionPersistence, cfg.JWT.RefreshTokenLifetime)
+
+	// 監査ログの依存関係の注入
+	auditLogUseCase := usecase.NewAuditLogUseCase(persistence.NewAuditLogPersistence(dbMysql))
+	graphql.Audit = auditLogUseCase
+
+	userHandler := handlerInterface.NewAuthController(userUseCase, sessionUseCase, auditLogUseCase, graphql, cfg)
+	graphql.Auth = userUseCase
+	graphql.Sessions = sessionUseCase
+
+	// パーソナルアクセストークンの依存関係の注入
+	tokenPersistence := persistence.NewPersonalAccessTokenPersistence(dbMysql)
+	tokenUseCase := usecase.NewPersonalAccessTokenUseCase(tokenPersistence)
+	graphql.Tokens = tokenUseCase
+
+	// 認可の依存関係の注入
+	permissionPersistence := persistence.NewPermissionPersistence(dbMysql)
+	rolePersistence := persistence.NewRolePersistence(dbMysql)
+	roleUseCase := usecase.NewRoleUseCase(rolePersistence)
+	graphql.Authz = usecase.NewAuthorizationUseCase(permissionPersistence, rolePersistence)
+	graphql.Roles = roleUseCase
 
-	userHandler := handlerInterface.NewAuthController(userUseCase, sessionUseCase, graphql)
+	// 公開設定の依存関係の注入
+	privacyUseCase := usecase.NewPrivacyUseCase(persistence.NewPrivacyPersistence(dbMysql), rolePersistence)
+	graphql.Privacy = privacyUseCase
+
+	// サブスクリプションの配信はRedisのpub/subで全インスタンスに届ける
+	graphql.PubSub = pubsub.New(dbRedis)
+
+	rateLimits := files.RateLimits
+	limiter := ratelimit.NewLimiter(dbRedis)
+
+	// クッキー認証のリクエストをフロントエンドのオリジンとCSRFトークンで検証する
+	csrfProtector := csrf.New(&cfg.Cookie, cfg.HostURL)
 
 	// Ginルーターを初期化
-	ginRouter := interfaces.NewRouter(userHandler)
+	ginRouter := interfaces.NewRouter(userHandler, ratelimit.Gin(limiter, rateLimits.Routes), csrfProtector, hostOrigin)
+	if err := ratelimit.ValidateRoutes(rateLimits.Routes, ginRouter.Routes()); err != nil {
+		return nil, fmt.Errorf("RATE_LIMITS_FILE: %w", err)
+	}
 
 	// CORSミドルウェア 開発用(仮)
 	cors := func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			origin := r.Header.Get("Origin")
-			if origin == hostUrl {
+			if origin == hostOrigin {
 				w.Header().Set("Access-Control-Allow-Origin", origin)
 				w.Header().Set("Access-Control-Allow-Credentials", "true")
 				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, "+csrf.HeaderName)
+				w.Header().Set("Access-Control-Expose-Headers", logger.RequestIDHeader)
 			}
 
 			if r.Method == http.MethodOptions {
@@ -64,34 +121,140 @@ func NewRouter(dbMysql *sqlx.DB, dbRedis *redis.Client, graphql *resolver.Resolv
 		w.Write([]byte("Hello World!!!!!!"))
 	})
 
+	// 稼働監視（MySQL・Redisに接続できない場合、/readyzは503を返す）
+	probe := health.New(cfg.Server.HealthCheckTimeout)
+	probe.Add("mysql", dbMysql.PingContext)
+	probe.Add("redis", func(ctx context.Context) error {
+		return dbRedis.Ping(ctx).Err()
+	})
+	mux.HandleFunc("/healthz", probe.Liveness)
+	mux.HandleFunc("/readyz", probe.Readiness)
+
+	// Prometheusのメトリクスは内部用のリスナーで公開する（Serveを参照）
+	metrics.RegisterPools(dbMysql, dbRedis)
+
 	// /pingエンドポイントをサーバールーターに直接追加
 	mux.HandleFunc("/api/ping", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.Write([]byte(`{"messages": "ping!!!!!!!!"}`))
 	})
 
+	// 他サービスがトークンを検証するための公開鍵
+	mux.HandleFunc("/.well-known/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		json.NewEncoder(w).Encode(jwtKeys.JWKS())
+	})
+
 	// GraphQL handler 設定
 	srv := handler.New(graph.NewExecutableSchema(graph.Config{
-		Resolvers: &resolver.Resolver{
-			DB: dbMysql,
+		Resolvers: graphql,
+		Directives: graph.DirectiveRoot{
+			Auth:       directive.Auth,
+			Owner:      directive.Owner,
+			Scope:      directive.Scope,
+			HasRole:    directive.NewHasRole(roleUseCase),
+			Visibility: directive.NewVisibility(privacyUseCase),
 		},
+		Complexity: resolver.Complexity(),
 	}))
 
 	srv.AddTransport(transport.Options{})
+	// サブスクリプションはWebSocket（graphql-ws）とSSEで受け付ける
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: csrfProtector.CheckOrigin,
+		},
+		InitFunc: auth.WebsocketInit(sessionUseCase, tokenUseCase),
+	})
 	srv.AddTransport(transport.GET{})
+	// SSEはPOSTと同じリクエストをAcceptで区別するため、POSTより先に登録する
+	srv.AddTransport(transport.SSE{})
 	srv.AddTransport(transport.POST{})
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
-	srv.Use(extension.Introspection{})
+	// スキーマの公開とプレイグラウンドは開発環境のみ
+	if cfg.IsDevelopment() {
+		srv.Use(extension.Introspection{})
+	}
+	if cfg.GraphQL.MaxDepth > 0 {
+		srv.Use(querylimit.Depth{Max: cfg.GraphQL.MaxDepth})
+	}
+	if cfg.GraphQL.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(cfg.GraphQL.MaxComplexity))
+	}
+	srv.Use(metrics.GraphQL{})
+	srv.Use(tracing.GraphQL{})
+	srv.SetErrorPresenter(tracing.ErrorPresenter(apperror.Present))
+	srv.AroundOperations(logger.GraphQL)
+	srv.AroundOperations(csrf.RejectMutationsOverGET)
+	srv.AroundOperations(directive.RequireReadScope)
+	srv.Use(ratelimit.NewGraphQL(limiter, rateLimits.GraphQL))
+	// 入力値の検証はリゾルバー（監査ログの記録）より先に行う
+	srv.Use(directive.NewValidation())
+	srv.Use(directive.NewAudit(auditLogUseCase, graphql.AuditTargetLoaders()))
+	// 公開設定の確認結果をリクエスト内で使い回す
+	srv.AroundOperations(func(ctx context.Context, next gqlgraphql.OperationHandler) gqlgraphql.ResponseHandler {
+		return next(usecase.WithPrivacyCache(ctx))
+	})
+	// 作品の関連をフィールドリゾルバーからまとめて取得する
+	srv.AroundRootFields(loader.Middleware(dbMysql))
+	// サブスクリプションはルートフィールドのミドルウェアを通らず、接続中は同じcontextで配信するため、
+	// 配信ごとにDataLoaderと公開設定のキャッシュを作り直す
+	srv.AroundResponses(func(ctx context.Context, next gqlgraphql.ResponseHandler) *gqlgraphql.Response {
+		if gqlgraphql.HasOperationContext(ctx) {
+			if op := gqlgraphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Subscription {
+				ctx = resolver.MessageContext(ctx, dbMysql)
+			}
+		}
+		return next(ctx)
+	})
+	// フロントエンドのクエリのマニフェストを登録する（本番環境ではそれ以外のクエリを拒否する）
+	if files.Manifest != nil {
+		srv.Use(persistedquery.Allowlist{Manifest: files.Manifest, Enforce: cfg.GraphQL.PersistedQueriesOnly})
+	}
+	// APQのクエリはRedisに保存し、再起動後や他のインスタンスでも使う
 	srv.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New[string](100),
+		Cache: persistedquery.NewCache(dbRedis, cfg.GraphQL.APQTTL),
 	})
 
 	// 既存のエンドポイントへのルーティング
-	mux.Handle("/api/v1/auth/", ginRouter)
-	mux.Handle("/", playground.Handler("GraphQL playground", "/api/query"))
+	// レート制限をユーザー単位で数えるため、認証のミドルウェアを通す
+	mux.Handle("/api/v1/auth/", auth.Middleware(sessionUseCase, tokenUseCase)(ginRouter))
+	if cfg.IsDevelopment() {
+		mux.Handle("/", playground.Handler("GraphQL playground", "/api/query"))
+	}
 
 	// GraphQLクエリエンドポイントのみを設定し、プレイグラウンドは明示的に設定しない
-	mux.Handle("/api/query", srv)
+	mux.Handle("/api/query", withoutDeadlineForStreams(auth.Middleware(sessionUseCase, tokenUseCase)(csrfProtector.Middleware(ratelimit.Middleware(srv)))))
+
+	return tracing.HTTP(mux, ratelimit.TrustedProxies(cfg.Server.TrustedProxies)(logger.Middleware(ratelimit.ClientIP)(cors(apperror.Middleware(withDeviceInfo(metrics.HTTP(mux))))))), nil
+}
+
+// 監査ログ・端末セッションに記録するリクエスト元の情報をcontextに設定する
+func withDeviceInfo(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := usecase.WithDeviceInfo(r.Context(), usecase.DeviceInfo{
+			UserAgent: r.UserAgent(),
+			IPAddress: ratelimit.ClientIP(r),
+		})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// WebSocket・SSEの接続がサーバーの読み書きのタイムアウトで切れないよう、期限を解除する
+func withoutDeadlineForStreams(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isStream(r) {
+			rc := http.NewResponseController(w)
+			rc.SetReadDeadline(time.Time{})
+			rc.SetWriteDeadline(time.Time{})
+		}
+		next.ServeHTTP(w, r)
+	})
+}
 
-	return cors(mux)
+func isStream(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
 }
@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// nextで変換したGraphQLのエラーにトレースIDを付与し、ログと突き合わせられるようにする
+func ErrorPresenter(next graphql.ErrorPresenterFunc) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		return withTraceID(ctx, next(ctx, err))
+	}
+}
+
+func withTraceID(ctx context.Context, gqlErr *gqlerror.Error) *gqlerror.Error {
+	traceID := TraceID(ctx)
+	if traceID == "" {
+		return gqlErr
+	}
+
+	// 使い回されるエラーもあるため、拡張情報はコピーしてから追加する
+	extensions := make(map[string]interface{}, len(gqlErr.Extensions)+1)
+	for k, v := range gqlErr.Extensions {
+		extensions[k] = v
+	}
+	extensions["traceId"] = traceID
+
+	presented := *gqlErr
+	presented.Extensions = extensions
+	return &presented
+}
@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// GraphQLの操作とリゾルバーごとにスパンを作成するgqlgenの拡張
+type GraphQL struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = GraphQL{}
+
+func (GraphQL) ExtensionName() string {
+	return "Tracing"
+}
+
+func (GraphQL) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (GraphQL) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	oc := graphql.GetOperationContext(ctx)
+	name := oc.OperationName
+	if name == "" {
+		name = "anonymous"
+	}
+	opType := "unknown"
+	if oc.Operation != nil {
+		opType = string(oc.Operation.Operation)
+	}
+
+	ctx, span := tracer().Start(ctx, "graphql."+opType+" "+name,
+		trace.WithAttributes(
+			attribute.String("graphql.operation.name", name),
+			attribute.String("graphql.operation.type", opType),
+		),
+	)
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		span.SetStatus(codes.Error, resp.Errors[0].Message)
+	}
+	return resp
+}
+
+func (GraphQL) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	// 構造体のフィールドを返すだけのものはスパンを作成しない
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := tracer().Start(ctx, fc.Object+"."+fc.Field.Name,
+		trace.WithAttributes(
+			attribute.String("graphql.field.path", fc.Path().String()),
+		),
+	)
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+type requestURLKey struct{}
+
+type requestURL struct {
+	url        *url.URL
+	requestURI string
+}
+
+// HTTPリクエストごとにスパンを作成する（traceparentヘッダーがあれば親スパンとして引き継ぐ）
+func HTTP(mux *http.ServeMux, next http.Handler) http.Handler {
+	traced := otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// スパンの記録後に元のURLに戻す
+		if orig, ok := r.Context().Value(requestURLKey{}).(requestURL); ok {
+			r.URL = orig.url
+			r.RequestURI = orig.requestURI
+		}
+		next.ServeHTTP(w, r)
+	}), "http.server",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			// スパン名の種類が増えすぎないよう、muxに登録したパターンを使う
+			_, route := mux.Handler(r)
+			if route == "" {
+				route = "unmatched"
+			}
+			return r.Method + " " + route
+		}),
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// OAuthの認可コードなどがスパンの属性に残らないよう、クエリ文字列を除いて記録する
+		ctx := context.WithValue(r.Context(), requestURLKey{}, requestURL{url: r.URL, requestURI: r.RequestURI})
+		scrubbed := r.WithContext(ctx)
+		u := *r.URL
+		u.RawQuery = ""
+		scrubbed.URL = &u
+		scrubbed.RequestURI = u.RequestURI()
+		traced.ServeHTTP(w, scrubbed)
+	})
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+)
+
+const (
+	// 監査ログの1ページの件数
+	DefaultAuditLogPageSize = 20
+	MaxAuditLogPageSize     = 100
+)
+
+// 監査ログに値を残さない項目（小文字・区切り文字なしで比較する）
+// 変更があったことは記録し、値だけを伏せる
+var redactedAuditFields = map[string]bool{
+	"token":        true,
+	"tokenhash":    true,
+	"accesstoken":  true,
+	"refreshtoken": true,
+	"password":     true,
+	"secret":       true,
+	"clientsecret": true,
+	"email":        true,
+}
+
+const redactedValue = "[REDACTED]"
+
+// 記録する操作（Before / Afterは変更前後の対象で、存在しない場合はnil）
+type AuditEntry struct {
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     interface{}
+	After      interface{}
+}
+
+type AuditLogPage struct {
+	Logs        []*model.AuditLog
+	HasNextPage bool
+}
+
+type AuditLogUsecase interface {
+	// 操作を記録する（リクエスト元の情報はcontextの端末情報から取得する）
+	Record(ctx context.Context, entry AuditEntry) error
+	List(ctx context.Context, filter model.AuditLogFilter, beforeID int64, limit int) (*AuditLogPage, error)
+}
+
+type auditLogUsecase struct {
+	auditLogRepository repository.AuditLogRepository
+}
+
+func NewAuditLogUseCase(auditLogRepository repository.AuditLogRepository) AuditLogUsecase {
+	return &auditLogUsecase{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (a *auditLogUsecase) Record(ctx context.Context, entry AuditEntry) error {
+	changes, err := auditChanges(entry.Before, entry.After)
+	if err != nil {
+		return err
+	}
+
+	device := DeviceInfoFromContext(ctx)
+	return a.auditLogRepository.Append(ctx, &model.AuditLog{
+		ActorID:    entry.ActorID,
+		Action:     entry.Action,
+		TargetType: entry.TargetType,
+		TargetID:   entry.TargetID,
+		Changes:    changes,
+		IPAddress:  device.IPAddress,
+		UserAgent:  device.UserAgent,
+		CreatedAt:  time.Now(),
+	})
+}
+
+func (a *auditLogUsecase) List(ctx context.Context, filter model.AuditLogFilter, beforeID int64, limit int) (*AuditLogPage, error) {
+	if limit <= 0 {
+		limit = DefaultAuditLogPageSize
+	}
+	if limit > MaxAuditLogPageSize {
+		limit = MaxAuditLogPageSize
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	logs, err := a.auditLogRepository.List(ctx, filter, beforeID, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AuditLogPage{Logs: logs}
+	if len(logs) > limit {
+		page.Logs = logs[:limit]
+		page.HasNextPage = true
+	}
+	return page, nil
+}
+
+// 変更前後で異なる項目を {"項目": {"before": ..., "after": ...}} の形式で返す（変更が無い場合はnil）
+// 秘密情報の項目は、変更の有無を比べた後で値を伏せる
+func auditChanges(before, after interface{}) (json.RawMessage, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	type change struct {
+		Before interface{} `json:"before"`
+		After  interface{} `json:"after"`
+	}
+	changes := make(map[string]change)
+	for key, value := range beforeFields {
+		if afterValue, ok := afterFields[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changes[key] = change{Before: redactAuditValue(key, value), After: redactAuditValue(key, afterValue)}
+		}
+	}
+	for key, value := range afterFields {
+		if _, ok := beforeFields[key]; !ok {
+			changes[key] = change{After: redactAuditValue(key, value)}
+		}
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit changes: %w", err)
+	}
+	return data, nil
+}
+
+// 対象をJSONの項目ごとに分解する（オブジェクト以外は "value" として扱う）
+func auditFields(v interface{}) (map[string]interface{}, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit target: %w", err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audit target: %w", err)
+	}
+
+	fields, ok := decoded.(map[string]interface{})
+	if !ok {
+		return map[string]interface{}{"value": decoded}, nil
+	}
+	return fields, nil
+}
+
+// 秘密情報の項目の値を伏せる（入れ子のオブジェクト・配列も含む、nilは変更前後の有無が分かるよう残す）
+func redactAuditValue(key string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	if redactedAuditFields[normalized] {
+		return redactedValue
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for k, nested := range v {
+			redacted[k] = redactAuditValue(k, nested)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, elem := range v {
+			redacted[i] = redactAuditValue("", elem)
+		}
+		return redacted
+	}
+	return value
+}
+
+type deviceInfoKey struct{}
+
+// リクエスト元の端末情報をcontextに設定する
+func WithDeviceInfo(ctx context.Context, device DeviceInfo) context.Context {
+	return context.WithValue(ctx, deviceInfoKey{}, device)
+}
+
+// contextの端末情報（設定されていない場合は空）
+func DeviceInfoFromContext(ctx context.Context) DeviceInfo {
+	device, _ := ctx.Value(deviceInfoKey{}).(DeviceInfo)
+	return device
+}
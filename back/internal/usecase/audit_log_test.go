@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type auditTestProfile struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Email       string            `json:"email,omitempty"`
+	AccessToken string            `json:"access_token,omitempty"`
+	Links       map[string]string `json:"links,omitempty"`
+	Accounts    []map[string]any  `json:"accounts,omitempty"`
+}
+
+func TestAuditChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		before interface{}
+		after  interface{}
+		// nilの場合は変更なし
+		want map[string]any
+	}{
+		{
+			name:   "create",
+			before: nil,
+			after:  &auditTestProfile{ID: "p1", Name: "Alice"},
+			want: map[string]any{
+				"id":   map[string]any{"before": nil, "after": "p1"},
+				"name": map[string]any{"before": nil, "after": "Alice"},
+			},
+		},
+		{
+			name:   "delete",
+			before: &auditTestProfile{ID: "p1", Name: "Alice"},
+			after:  nil,
+			want: map[string]any{
+				"id":   map[string]any{"before": "p1", "after": nil},
+				"name": map[string]any{"before": "Alice", "after": nil},
+			},
+		},
+		{
+			name:   "typed nil pointer is treated as absent",
+			before: (*auditTestProfile)(nil),
+			after:  &auditTestProfile{ID: "p1"},
+			want: map[string]any{
+				"id":   map[string]any{"before": nil, "after": "p1"},
+				"name": map[string]any{"before": nil, "after": ""},
+			},
+		},
+		{
+			name:   "only changed fields",
+			before: &auditTestProfile{ID: "p1", Name: "Alice"},
+			after:  &auditTestProfile{ID: "p1", Name: "Bob"},
+			want: map[string]any{
+				"name": map[string]any{"before": "Alice", "after": "Bob"},
+			},
+		},
+		{
+			name:   "no changes",
+			before: &auditTestProfile{ID: "p1", Name: "Alice"},
+			after:  &auditTestProfile{ID: "p1", Name: "Alice"},
+		},
+		{
+			// 値は伏せるが、変更されたことは残す
+			name:   "email and token are redacted",
+			before: &auditTestProfile{ID: "p1", Email: "alice@example.com", AccessToken: "old"},
+			after:  &auditTestProfile{ID: "p1", Email: "bob@example.com", AccessToken: "new"},
+			want: map[string]any{
+				"email":        map[string]any{"before": redactedValue, "after": redactedValue},
+				"access_token": map[string]any{"before": redactedValue, "after": redactedValue},
+			},
+		},
+		{
+			name:   "added secret is redacted",
+			before: &auditTestProfile{ID: "p1"},
+			after:  &auditTestProfile{ID: "p1", Email: "alice@example.com"},
+			want: map[string]any{
+				"email": map[string]any{"before": nil, "after": redactedValue},
+			},
+		},
+		{
+			name:   "nested maps and lists are redacted",
+			before: &auditTestProfile{ID: "p1"},
+			after: &auditTestProfile{
+				ID:       "p1",
+				Links:    map[string]string{"refreshToken": "r", "site": "https://example.com"},
+				Accounts: []map[string]any{{"provider": "google", "Email": "alice@example.com"}},
+			},
+			want: map[string]any{
+				"links":    map[string]any{"before": nil, "after": map[string]any{"refreshToken": redactedValue, "site": "https://example.com"}},
+				"accounts": map[string]any{"before": nil, "after": []any{map[string]any{"provider": "google", "Email": redactedValue}}},
+			},
+		},
+		{
+			name:   "scalar values",
+			before: 1,
+			after:  2,
+			want: map[string]any{
+				"value": map[string]any{"before": float64(1), "after": float64(2)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := auditChanges(tt.before, tt.after)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want == nil {
+				if data != nil {
+					t.Fatalf("changes = %s, want nil", data)
+				}
+				return
+			}
+			var got map[string]any
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changes = %s, want %v", data, tt.want)
+			}
+		})
+	}
+}
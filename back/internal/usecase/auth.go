@@ -2,7 +2,7 @@ package usecase
 
 import (
 	"context"
-	"log"
+	"errors"
 	"time"
 
 	"github.com/noonyuu/nfc/back/graph/model" // graphqlで生成されたmodelをインポート
@@ -15,9 +15,23 @@ import (
 type AuthUsecase interface {
 	Create(ctx context.Context, input *CreateUserDTO, user goth.User) (*model.User, error)
 	GetByUserID(ctx context.Context, input *GetByUserIdDTO) (*GetUserOutput, error)
-	FindByEmail(ctx context.Context, email string, userID string, provider string) (*model.User, error)
+	Login(ctx context.Context, input *CreateUserDTO, user goth.User) (*model.User, error)
+	LinkProvider(ctx context.Context, userID string, user goth.User) error
+	ListProviders(ctx context.Context, userID string) ([]*model.Provider, error)
+	UnlinkProvider(ctx context.Context, userID string, provider string) error
 }
 
+var (
+	// メールアドレスが一致する既存ユーザーがいるが、プロバイダーが紐づいていない
+	ErrAccountConflict = errors.New("account exists with a different provider")
+	// プロバイダーのアカウントが別のユーザーに紐づいている
+	ErrProviderLinkedToOtherUser = errors.New("provider account is linked to another user")
+	// 同じ種類のプロバイダーが既に紐づいている
+	ErrProviderAlreadyLinked = errors.New("provider is already linked")
+	// 最後のプロバイダーは解除できない
+	ErrLastProvider = errors.New("cannot unlink the last provider")
+)
+
 type CreateUserDTO struct {
 	Name      string
 	Email     string
@@ -88,16 +102,85 @@ func (u *authUseCase) GetByUserID(ctx context.Context, input *GetByUserIdDTO) (*
 	return output, nil
 }
 
-func (u *authUseCase) FindByEmail(ctx context.Context, email string, userID string, provider string) (*model.User, error) {
-	user, err := u.userRepository.FindByEmail(ctx, email, userID, provider)
+// プロバイダーのアカウントでログインする
+// 未登録のアカウントで、メールアドレスが既存ユーザーと一致する場合は自動で紐づけずにErrAccountConflictを返す
+func (u *authUseCase) Login(ctx context.Context, input *CreateUserDTO, userGoth goth.User) (*model.User, error) {
+	user, err := u.userRepository.FindByProvider(ctx, userGoth.Provider, userGoth.UserID)
 	if err != nil {
 		return nil, err
 	}
+	if user != nil {
+		return user, nil
+	}
 
-	if user == nil {
-		return nil, nil
+	if input.Email != "" {
+		existing, err := u.userRepository.FindByEmail(ctx, input.Email)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, ErrAccountConflict
+		}
 	}
-	log.Printf("user: %v", user)
 
-	return user, nil
+	return u.Create(ctx, input, userGoth)
+}
+
+// ログイン中のユーザーにプロバイダーのアカウントを紐づける
+func (u *authUseCase) LinkProvider(ctx context.Context, userID string, userGoth goth.User) error {
+	owner, err := u.userRepository.FindByProvider(ctx, userGoth.Provider, userGoth.UserID)
+	if err != nil {
+		return err
+	}
+	if owner != nil {
+		if owner.ID == userID {
+			// 既に紐づいている
+			return nil
+		}
+		return ErrProviderLinkedToOtherUser
+	}
+
+	providers, err := u.userRepository.ListProviders(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, p := range providers {
+		if p.Provider == userGoth.Provider {
+			return ErrProviderAlreadyLinked
+		}
+	}
+
+	return u.userRepository.LinkProvider(ctx, userID, userGoth.Provider, userGoth.UserID)
+}
+
+func (u *authUseCase) ListProviders(ctx context.Context, userID string) ([]*model.Provider, error) {
+	return u.userRepository.ListProviders(ctx, userID)
+}
+
+// プロバイダーの紐づけを解除する（ログイン手段が無くならないよう最後の1つは解除できない）
+func (u *authUseCase) UnlinkProvider(ctx context.Context, userID string, provider string) error {
+	providers, err := u.userRepository.ListProviders(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	linked := false
+	for _, p := range providers {
+		if p.Provider == provider {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return ErrResourceNotFound
+	}
+
+	deleted, err := u.userRepository.UnlinkProvider(ctx, userID, provider)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrLastProvider
+	}
+	return nil
 }
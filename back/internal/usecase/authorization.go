@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+)
+
+// 権限が拒否された理由
+const (
+	DenyReasonNotWorkMember     = "NOT_WORK_MEMBER"
+	DenyReasonNotProfileOwner   = "NOT_PROFILE_OWNER"
+	DenyReasonNotEventOrganizer = "NOT_EVENT_ORGANIZER"
+)
+
+// 操作対象のリソースが存在しない
+var ErrResourceNotFound = errors.New("resource not found")
+
+// 操作の権限が無い場合のエラー
+type PermissionDeniedError struct {
+	Reason string
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return "permission denied: " + e.Reason
+}
+
+type AuthorizationUsecase interface {
+	CanEditWork(ctx context.Context, userID string, workID string) error
+	CanEditWorkProfile(ctx context.Context, userID string, workProfileID string) error
+	CanEditWorkSkill(ctx context.Context, userID string, workSkillID int32) error
+	CanEditProfile(ctx context.Context, userID string, profileID string) error
+	CanEditProfileSkill(ctx context.Context, userID string, profileSkillID int32) error
+	CanEditEvent(ctx context.Context, userID string, eventID string) error
+	CanManageEventOrganizers(ctx context.Context, userID string, eventID string) error
+}
+
+type authorizationUseCase struct {
+	permissionRepository repository.PermissionRepository
+	roleRepository       repository.RoleRepository
+}
+
+func NewAuthorizationUseCase(permissionRepository repository.PermissionRepository, roleRepository repository.RoleRepository) AuthorizationUsecase {
+	return &authorizationUseCase{
+		permissionRepository: permissionRepository,
+		roleRepository:       roleRepository,
+	}
+}
+
+// 権限が拒否された場合でも、モデレーター以上であれば許可する
+func (a *authorizationUseCase) allowModerator(ctx context.Context, userID string, err error) error {
+	var denied *PermissionDeniedError
+	if !errors.As(err, &denied) {
+		return err
+	}
+
+	role, roleErr := a.roleRepository.GetRole(ctx, userID)
+	if roleErr != nil {
+		return roleErr
+	}
+	if role.Includes(model.RoleModerator) {
+		return nil
+	}
+	return err
+}
+
+// 作品の編集はwork_profilesに登録されたメンバーのみ（モデレーター以上は全ての作品を編集可能）
+func (a *authorizationUseCase) CanEditWork(ctx context.Context, userID, workID string) error {
+	return a.allowModerator(ctx, userID, a.canEditWork(ctx, userID, workID))
+}
+
+func (a *authorizationUseCase) canEditWork(ctx context.Context, userID, workID string) error {
+	isMember, err := a.permissionRepository.IsWorkMember(ctx, workID, userID)
+	if err != nil {
+		return err
+	}
+	if isMember {
+		return nil
+	}
+
+	exists, err := a.permissionRepository.WorkExists(ctx, workID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrResourceNotFound
+	}
+	return &PermissionDeniedError{Reason: DenyReasonNotWorkMember}
+}
+
+// 作品メンバーの追加・削除は作品のメンバーのみ
+func (a *authorizationUseCase) CanEditWorkProfile(ctx context.Context, userID, workProfileID string) error {
+	workID, err := a.permissionRepository.FindWorkIDByWorkProfileID(ctx, workProfileID)
+	if err != nil {
+		return err
+	}
+	if workID == "" {
+		return ErrResourceNotFound
+	}
+	return a.CanEditWork(ctx, userID, workID)
+}
+
+// 作品スキルの編集は作品のメンバーのみ
+func (a *authorizationUseCase) CanEditWorkSkill(ctx context.Context, userID string, workSkillID int32) error {
+	workID, err := a.permissionRepository.FindWorkIDByWorkSkillID(ctx, workSkillID)
+	if err != nil {
+		return err
+	}
+	if workID == "" {
+		return ErrResourceNotFound
+	}
+	return a.CanEditWork(ctx, userID, workID)
+}
+
+// プロフィールの編集は本人のみ（プロフィールIDはユーザーIDと同一、モデレーター以上は編集可能）
+func (a *authorizationUseCase) CanEditProfile(ctx context.Context, userID, profileID string) error {
+	if userID != profileID {
+		return a.allowModerator(ctx, userID, &PermissionDeniedError{Reason: DenyReasonNotProfileOwner})
+	}
+	return nil
+}
+
+// プロフィールスキルの編集はプロフィールの本人のみ
+func (a *authorizationUseCase) CanEditProfileSkill(ctx context.Context, userID string, profileSkillID int32) error {
+	profileID, err := a.permissionRepository.FindProfileIDByProfileSkillID(ctx, profileSkillID)
+	if err != nil {
+		return err
+	}
+	if profileID == "" {
+		return ErrResourceNotFound
+	}
+	return a.CanEditProfile(ctx, userID, profileID)
+}
+
+// イベントの編集は作成者または運営者のみ（モデレーター以上は全てのイベントを編集可能）
+func (a *authorizationUseCase) CanEditEvent(ctx context.Context, userID, eventID string) error {
+	return a.allowModerator(ctx, userID, a.canEditEvent(ctx, userID, eventID))
+}
+
+func (a *authorizationUseCase) canEditEvent(ctx context.Context, userID, eventID string) error {
+	creator, found, err := a.permissionRepository.FindEventCreator(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrResourceNotFound
+	}
+	// 作成者のいないイベントは運営者のみ編集できる
+	if creator != "" && creator == userID {
+		return nil
+	}
+
+	isOrganizer, err := a.permissionRepository.IsEventOrganizer(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if !isOrganizer {
+		return &PermissionDeniedError{Reason: DenyReasonNotEventOrganizer}
+	}
+	return nil
+}
+
+// 運営者の追加・削除はイベントの作成者のみ（モデレーター以上は全てのイベントで可能）
+func (a *authorizationUseCase) CanManageEventOrganizers(ctx context.Context, userID, eventID string) error {
+	creator, found, err := a.permissionRepository.FindEventCreator(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrResourceNotFound
+	}
+	if creator == "" || creator != userID {
+		return a.allowModerator(ctx, userID, &PermissionDeniedError{Reason: DenyReasonNotEventOrganizer})
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+// イベントの作成者・運営者だけを返す権限リポジトリ
+type stubPermissionRepository struct {
+	// イベントID → 作成者（空文字は作成者なし）
+	creators   map[string]string
+	organizers map[[2]string]bool
+}
+
+func (r *stubPermissionRepository) WorkExists(ctx context.Context, workID string) (bool, error) {
+	return false, nil
+}
+
+func (r *stubPermissionRepository) IsWorkMember(ctx context.Context, workID, userID string) (bool, error) {
+	return false, nil
+}
+
+func (r *stubPermissionRepository) FindWorkIDByWorkProfileID(ctx context.Context, workProfileID string) (string, error) {
+	return "", nil
+}
+
+func (r *stubPermissionRepository) FindWorkIDByWorkSkillID(ctx context.Context, workSkillID int32) (string, error) {
+	return "", nil
+}
+
+func (r *stubPermissionRepository) FindProfileIDByProfileSkillID(ctx context.Context, profileSkillID int32) (string, error) {
+	return "", nil
+}
+
+func (r *stubPermissionRepository) FindEventCreator(ctx context.Context, eventID string) (string, bool, error) {
+	creator, ok := r.creators[eventID]
+	return creator, ok, nil
+}
+
+func (r *stubPermissionRepository) IsEventOrganizer(ctx context.Context, eventID, userID string) (bool, error) {
+	return r.organizers[[2]string{eventID, userID}], nil
+}
+
+func TestCanEditEvent(t *testing.T) {
+	a := NewAuthorizationUseCase(
+		&stubPermissionRepository{
+			creators:   map[string]string{"event": "creator", "orphan": ""},
+			organizers: map[[2]string]bool{{"event", "organizer"}: true, {"orphan", "organizer"}: true},
+		},
+		stubRoleRepository{"moderator": model.RoleModerator},
+	)
+
+	tests := []struct {
+		name       string
+		userID     string
+		eventID    string
+		wantDenied bool
+		wantErr    error
+	}{
+		{"creator", "creator", "event", false, nil},
+		{"organizer", "organizer", "event", false, nil},
+		{"stranger", "stranger", "event", true, nil},
+		{"moderator", "moderator", "event", false, nil},
+		{"missing event", "creator", "missing", false, ErrResourceNotFound},
+		{"event without creator, organizer", "organizer", "orphan", false, nil},
+		{"event without creator, stranger", "stranger", "orphan", true, nil},
+		{"event without creator, empty user", "", "orphan", true, nil},
+		{"event without creator, moderator", "moderator", "orphan", false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.CanEditEvent(context.Background(), tt.userID, tt.eventID)
+			var denied *PermissionDeniedError
+			if got := errors.As(err, &denied); got != tt.wantDenied {
+				t.Fatalf("err = %v, want denied %v", err, tt.wantDenied)
+			}
+			if !tt.wantDenied && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCanManageEventOrganizers(t *testing.T) {
+	a := NewAuthorizationUseCase(
+		&stubPermissionRepository{creators: map[string]string{"event": "creator", "orphan": ""}},
+		stubRoleRepository{"moderator": model.RoleModerator},
+	)
+
+	tests := []struct {
+		name       string
+		userID     string
+		eventID    string
+		wantDenied bool
+		wantErr    error
+	}{
+		{"creator", "creator", "event", false, nil},
+		{"stranger", "stranger", "event", true, nil},
+		{"missing event", "creator", "missing", false, ErrResourceNotFound},
+		{"event without creator", "stranger", "orphan", true, nil},
+		{"event without creator, moderator", "moderator", "orphan", false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.CanManageEventOrganizers(context.Background(), tt.userID, tt.eventID)
+			var denied *PermissionDeniedError
+			if got := errors.As(err, &denied); got != tt.wantDenied {
+				t.Fatalf("err = %v, want denied %v", err, tt.wantDenied)
+			}
+			if !tt.wantDenied && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
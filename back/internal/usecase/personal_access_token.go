@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/noonyuu/nfc/back/graph/model"
+	"github.com/noonyuu/nfc/back/internal/auth"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// 有効期限の指定が無い場合の日数と上限
+	DefaultTokenExpireDays = 30
+	MaxTokenExpireDays     = 365
+
+	// 最終使用日時の更新間隔（リクエストごとの書き込みを避ける）
+	tokenLastUsedInterval = time.Minute
+)
+
+var (
+	// トークンの名前やスコープ、有効期限が不正
+	ErrInvalidTokenInput = errors.New("invalid personal access token input")
+	// トークンが存在しない・期限切れ
+	ErrInvalidPersonalAccessToken = errors.New("invalid personal access token")
+)
+
+type CreatePersonalAccessTokenDTO struct {
+	Name   string
+	Scopes []string
+	// nilの場合はDefaultTokenExpireDays
+	ExpiresInDays *int
+}
+
+type PersonalAccessTokenUsecase interface {
+	// トークンを作成し、平文のトークンを返す（平文は保存しないため再表示できない）
+	Create(ctx context.Context, userID string, input *CreatePersonalAccessTokenDTO) (*model.PersonalAccessToken, string, error)
+	List(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error)
+	Revoke(ctx context.Context, userID string, id string) error
+	// 平文のトークンを検証し、最終使用日時を更新する
+	Authenticate(ctx context.Context, token string) (*model.PersonalAccessToken, error)
+	// トークンが削除・期限切れになっていないか（接続中のWebSocketの確認に使う）
+	IsActive(ctx context.Context, userID string, id string) (bool, error)
+}
+
+type personalAccessTokenUsecase struct {
+	tokenRepository repository.PersonalAccessTokenRepository
+}
+
+func NewPersonalAccessTokenUseCase(tokenRepository repository.PersonalAccessTokenRepository) PersonalAccessTokenUsecase {
+	return &personalAccessTokenUsecase{
+		tokenRepository: tokenRepository,
+	}
+}
+
+func (p *personalAccessTokenUsecase) Create(ctx context.Context, userID string, input *CreatePersonalAccessTokenDTO) (*model.PersonalAccessToken, string, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > 255 {
+		return nil, "", ErrInvalidTokenInput
+	}
+
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	days := DefaultTokenExpireDays
+	if input.ExpiresInDays != nil {
+		days = *input.ExpiresInDays
+	}
+	if days < 1 || days > MaxTokenExpireDays {
+		return nil, "", ErrInvalidTokenInput
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	plaintext := auth.PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	now := time.Now()
+	expiresAt := now.AddDate(0, 0, days)
+	token := &model.PersonalAccessToken{
+		ID:        id.String(),
+		UserID:    userID,
+		Name:      name,
+		TokenHash: hashPersonalAccessToken(plaintext),
+		Scopes:    scopes,
+		ExpiresAt: &expiresAt,
+		CreatedAt: now,
+	}
+	if err := p.tokenRepository.Create(ctx, token); err != nil {
+		return nil, "", err
+	}
+
+	return token, plaintext, nil
+}
+
+func (p *personalAccessTokenUsecase) List(ctx context.Context, userID string) ([]*model.PersonalAccessToken, error) {
+	return p.tokenRepository.ListByUserID(ctx, userID)
+}
+
+// 自分のトークンのみ削除可能
+func (p *personalAccessTokenUsecase) Revoke(ctx context.Context, userID, id string) error {
+	deleted, err := p.tokenRepository.Delete(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrResourceNotFound
+	}
+	return nil
+}
+
+func (p *personalAccessTokenUsecase) Authenticate(ctx context.Context, plaintext string) (*model.PersonalAccessToken, error) {
+	if !strings.HasPrefix(plaintext, auth.PersonalAccessTokenPrefix) {
+		return nil, ErrInvalidPersonalAccessToken
+	}
+
+	token, err := p.tokenRepository.FindByHash(ctx, hashPersonalAccessToken(plaintext))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if token == nil || (token.ExpiresAt != nil && now.After(*token.ExpiresAt)) {
+		return nil, ErrInvalidPersonalAccessToken
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= tokenLastUsedInterval {
+		// 更新に失敗しても認証自体は成功させる
+		if err := p.tokenRepository.UpdateLastUsedAt(ctx, token.ID, now); err != nil {
+			slog.ErrorContext(ctx, "failed to update token last used at", "error", err)
+		} else {
+			token.LastUsedAt = &now
+		}
+	}
+
+	return token, nil
+}
+
+func (p *personalAccessTokenUsecase) IsActive(ctx context.Context, userID, id string) (bool, error) {
+	tokens, err := p.tokenRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, token := range tokens {
+		if token.ID == id {
+			return token.ExpiresAt == nil || time.Now().Before(*token.ExpiresAt), nil
+		}
+	}
+	return false, nil
+}
+
+// トークンはSHA-256のハッシュ値のみ保存する（十分なエントロピーがあるためソルトは不要）
+func hashPersonalAccessToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
+
+// スコープを検証し、重複を除いて定義順に並べる
+func normalizeScopes(scopes []string) ([]string, error) {
+	requested := make(map[string]bool, len(scopes))
+	for _, s := range scopes {
+		requested[s] = true
+	}
+
+	normalized := make([]string, 0, len(requested))
+	for _, s := range auth.Scopes {
+		if requested[s] {
+			normalized = append(normalized, s)
+			delete(requested, s)
+		}
+	}
+	if len(requested) > 0 || len(normalized) == 0 {
+		return nil, ErrInvalidTokenInput
+	}
+	return normalized, nil
+}
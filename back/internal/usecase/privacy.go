@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+)
+
+// 未定義の公開範囲
+var ErrInvalidVisibility = errors.New("invalid visibility")
+
+type PrivacyUsecase interface {
+	// プロフィールの公開設定を取得（設定が無い場合は既定の設定）
+	GetSettings(ctx context.Context, profileID string) (*model.PrivacySettings, error)
+	UpdateSettings(ctx context.Context, settings *model.PrivacySettings) error
+	// viewerIDのユーザーから見たownerIDのユーザーとの関係（未ログインの場合はviewerIDが空文字）
+	Relationship(ctx context.Context, viewerID string, ownerID string) (model.Relationship, error)
+	// ownerIDのユーザーの項目をviewerIDのユーザーが閲覧できるか（onCardはNFCカードとしての表示）
+	CanView(ctx context.Context, viewerID string, ownerID string, field model.PrivacyField, onCard bool) (bool, error)
+}
+
+type privacyUsecase struct {
+	privacyRepository repository.PrivacyRepository
+	roleRepository    repository.RoleRepository
+}
+
+func NewPrivacyUseCase(privacyRepository repository.PrivacyRepository, roleRepository repository.RoleRepository) PrivacyUsecase {
+	return &privacyUsecase{
+		privacyRepository: privacyRepository,
+		roleRepository:    roleRepository,
+	}
+}
+
+func (p *privacyUsecase) GetSettings(ctx context.Context, profileID string) (*model.PrivacySettings, error) {
+	cache := privacyCacheFromContext(ctx)
+	if cache != nil {
+		if v, ok := cache.settings.Load(profileID); ok {
+			return v.(*model.PrivacySettings), nil
+		}
+	}
+
+	settings, err := p.privacyRepository.GetSettings(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = model.DefaultPrivacySettings(profileID)
+	}
+
+	if cache != nil {
+		cache.settings.Store(profileID, settings)
+	}
+	return settings, nil
+}
+
+func (p *privacyUsecase) UpdateSettings(ctx context.Context, settings *model.PrivacySettings) error {
+	for _, v := range []model.Visibility{
+		settings.Email,
+		settings.Name,
+		settings.AvatarURL,
+		settings.GraduationYear,
+		settings.Affiliation,
+		settings.Bio,
+	} {
+		if !v.IsValid() {
+			return ErrInvalidVisibility
+		}
+	}
+
+	if err := p.privacyRepository.SaveSettings(ctx, settings); err != nil {
+		return err
+	}
+	if cache := privacyCacheFromContext(ctx); cache != nil {
+		cache.settings.Store(settings.ProfileID, settings)
+	}
+	return nil
+}
+
+// 本人と管理者は全ての項目を閲覧でき、それ以外は参加イベントの運営者、作品の共同制作者の順に判定する
+func (p *privacyUsecase) Relationship(ctx context.Context, viewerID, ownerID string) (model.Relationship, error) {
+	if viewerID == "" {
+		return model.RelationshipNone, nil
+	}
+	if viewerID == ownerID {
+		return model.RelationshipSelf, nil
+	}
+
+	cache := privacyCacheFromContext(ctx)
+	key := viewerID + ":" + ownerID
+	if cache != nil {
+		if v, ok := cache.relationships.Load(key); ok {
+			return v.(model.Relationship), nil
+		}
+	}
+
+	relationship, err := p.relationship(ctx, viewerID, ownerID)
+	if err != nil {
+		return model.RelationshipNone, err
+	}
+
+	if cache != nil {
+		cache.relationships.Store(key, relationship)
+	}
+	return relationship, nil
+}
+
+func (p *privacyUsecase) relationship(ctx context.Context, viewerID, ownerID string) (model.Relationship, error) {
+	role, err := p.roleRepository.GetRole(ctx, viewerID)
+	if err != nil {
+		return model.RelationshipNone, err
+	}
+	if role.Includes(model.RoleAdmin) {
+		return model.RelationshipSelf, nil
+	}
+
+	isOrganizer, err := p.privacyRepository.IsOrganizerOfUserEvent(ctx, viewerID, ownerID)
+	if err != nil {
+		return model.RelationshipNone, err
+	}
+	if isOrganizer {
+		return model.RelationshipEventOrganizer, nil
+	}
+
+	isCollaborator, err := p.privacyRepository.AreWorkCollaborators(ctx, viewerID, ownerID)
+	if err != nil {
+		return model.RelationshipNone, err
+	}
+	if isCollaborator {
+		return model.RelationshipConnection, nil
+	}
+	return model.RelationshipNone, nil
+}
+
+// NFCカードではカードの表示設定に従い、それ以外は公開範囲と閲覧者との関係で判定する
+func (p *privacyUsecase) CanView(ctx context.Context, viewerID, ownerID string, field model.PrivacyField, onCard bool) (bool, error) {
+	relationship, err := p.Relationship(ctx, viewerID, ownerID)
+	if err != nil {
+		return false, err
+	}
+	if relationship == model.RelationshipSelf {
+		return true, nil
+	}
+
+	settings, err := p.GetSettings(ctx, ownerID)
+	if err != nil {
+		return false, err
+	}
+	if onCard {
+		if show, ok := settings.ShowOnCard(field); ok {
+			return show, nil
+		}
+	}
+	return settings.VisibilityOf(field).VisibleTo(relationship), nil
+}
+
+// 1リクエスト内で公開設定と閲覧者との関係を使い回すためのキャッシュ
+type privacyCache struct {
+	settings      sync.Map
+	relationships sync.Map
+}
+
+type privacyCacheKey struct{}
+
+// リクエスト単位のキャッシュをcontextに設定する
+func WithPrivacyCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, privacyCacheKey{}, &privacyCache{})
+}
+
+func privacyCacheFromContext(ctx context.Context) *privacyCache {
+	cache, _ := ctx.Value(privacyCacheKey{}).(*privacyCache)
+	return cache
+}
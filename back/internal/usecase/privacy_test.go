@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+type stubPrivacyRepository struct {
+	settings      map[string]*model.PrivacySettings
+	organizers    map[[2]string]bool
+	collaborators map[[2]string]bool
+}
+
+func (r *stubPrivacyRepository) GetSettings(ctx context.Context, profileID string) (*model.PrivacySettings, error) {
+	return r.settings[profileID], nil
+}
+
+func (r *stubPrivacyRepository) SaveSettings(ctx context.Context, settings *model.PrivacySettings) error {
+	r.settings[settings.ProfileID] = settings
+	return nil
+}
+
+func (r *stubPrivacyRepository) AreWorkCollaborators(ctx context.Context, userID, otherUserID string) (bool, error) {
+	return r.collaborators[[2]string{userID, otherUserID}], nil
+}
+
+func (r *stubPrivacyRepository) IsOrganizerOfUserEvent(ctx context.Context, organizerID, userID string) (bool, error) {
+	return r.organizers[[2]string{organizerID, userID}], nil
+}
+
+type stubRoleRepository map[string]model.Role
+
+func (r stubRoleRepository) GetRole(ctx context.Context, userID string) (model.Role, error) {
+	return r[userID], nil
+}
+
+func (r stubRoleRepository) SetRole(ctx context.Context, userID string, role model.Role) (bool, error) {
+	r[userID] = role
+	return true, nil
+}
+
+func (r stubRoleRepository) CountByRole(ctx context.Context, role model.Role) (int, error) {
+	return 0, nil
+}
+
+func TestCanView(t *testing.T) {
+	const owner = "owner"
+	settings := &model.PrivacySettings{
+		ProfileID:      owner,
+		Email:          model.VisibilitySelf,
+		Name:           model.VisibilityConnections,
+		AvatarURL:      model.VisibilityPublic,
+		GraduationYear: model.VisibilityEventOrganizers,
+		Affiliation:    model.VisibilityConnections,
+		Bio:            model.VisibilitySelf,
+		CardAvatarURL:  true,
+		CardBio:        true,
+	}
+	p := NewPrivacyUseCase(
+		&stubPrivacyRepository{
+			settings:      map[string]*model.PrivacySettings{owner: settings},
+			organizers:    map[[2]string]bool{{"organizer", owner}: true},
+			collaborators: map[[2]string]bool{{"collaborator", owner}: true},
+		},
+		stubRoleRepository{"admin": model.RoleAdmin, "stranger": model.RoleUser, "organizer": model.RoleUser, "collaborator": model.RoleUser},
+	)
+
+	tests := []struct {
+		viewer string
+		field  model.PrivacyField
+		onCard bool
+		want   bool
+	}{
+		// 未ログイン・関係なし
+		{"", model.PrivacyFieldAvatarURL, false, true},
+		{"", model.PrivacyFieldName, false, false},
+		{"", model.PrivacyFieldBio, false, false},
+		{"stranger", model.PrivacyFieldAffiliation, false, false},
+		{"stranger", model.PrivacyFieldGraduationYear, false, false},
+		// 共同制作者
+		{"collaborator", model.PrivacyFieldName, false, true},
+		{"collaborator", model.PrivacyFieldAffiliation, false, true},
+		{"collaborator", model.PrivacyFieldGraduationYear, false, false},
+		{"collaborator", model.PrivacyFieldEmail, false, false},
+		// イベントの運営者
+		{"organizer", model.PrivacyFieldGraduationYear, false, true},
+		{"organizer", model.PrivacyFieldAffiliation, false, true},
+		{"organizer", model.PrivacyFieldBio, false, false},
+		// 本人・管理者
+		{owner, model.PrivacyFieldEmail, false, true},
+		{owner, model.PrivacyFieldGraduationYear, true, true},
+		{"admin", model.PrivacyFieldEmail, false, true},
+		// NFCカードではカードの表示設定に従う
+		{"", model.PrivacyFieldBio, true, true},
+		{"", model.PrivacyFieldGraduationYear, true, false},
+		{"organizer", model.PrivacyFieldGraduationYear, true, false},
+		{"", model.PrivacyFieldName, true, false},
+		{"collaborator", model.PrivacyFieldName, true, true},
+	}
+
+	for _, tt := range tests {
+		got, err := p.CanView(context.Background(), tt.viewer, owner, tt.field, tt.onCard)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("CanView(viewer=%q, field=%s, onCard=%v) = %v, want %v", tt.viewer, tt.field, tt.onCard, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+	"github.com/noonyuu/nfc/back/internal/domain/repository"
+)
+
+var (
+	// 未定義の権限
+	ErrInvalidRole = errors.New("invalid role")
+	// 最後の管理者の権限は変更できない
+	ErrLastAdmin = repository.ErrLastAdmin
+)
+
+type RoleUsecase interface {
+	// ユーザーの権限を取得（ユーザーが存在しない場合はErrResourceNotFound）
+	GetRole(ctx context.Context, userID string) (model.Role, error)
+	// ユーザーがrequiredの権限を持っているか
+	HasRole(ctx context.Context, userID string, required model.Role) (bool, error)
+	SetRole(ctx context.Context, userID string, role model.Role) error
+}
+
+type roleUsecase struct {
+	roleRepository repository.RoleRepository
+}
+
+func NewRoleUseCase(roleRepository repository.RoleRepository) RoleUsecase {
+	return &roleUsecase{
+		roleRepository: roleRepository,
+	}
+}
+
+func (r *roleUsecase) GetRole(ctx context.Context, userID string) (model.Role, error) {
+	role, err := r.roleRepository.GetRole(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if role == "" {
+		return "", ErrResourceNotFound
+	}
+	return role, nil
+}
+
+func (r *roleUsecase) HasRole(ctx context.Context, userID string, required model.Role) (bool, error) {
+	role, err := r.roleRepository.GetRole(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return role.Includes(required), nil
+}
+
+// 権限を変更する（管理者が居なくならないよう、最後の管理者は降格できない）
+func (r *roleUsecase) SetRole(ctx context.Context, userID string, role model.Role) error {
+	if !role.IsValid() {
+		return ErrInvalidRole
+	}
+
+	current, err := r.GetRole(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if current == role {
+		return nil
+	}
+
+	// 最後の管理者かどうかはリポジトリが更新と同じトランザクションで確認する
+	updated, err := r.roleRepository.SetRole(ctx, userID, role)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return ErrResourceNotFound
+	}
+	return nil
+}
@@ -2,25 +2,60 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/noonyuu/nfc/back/internal/config"
 	"github.com/noonyuu/nfc/back/internal/domain/model"
 	"github.com/noonyuu/nfc/back/internal/domain/repository"
+
+	"github.com/google/uuid"
+)
+
+// ローテーション直後の古いリフレッシュトークンを再利用とみなさない期間
+const RefreshTokenReuseGracePeriod = 30 * time.Second
+
+// 同時のローテーションで更新に失敗した場合に読み直す回数
+const maxRotateAttempts = 3
+
+var (
+	// リフレッシュトークンが無効、またはセッションが失効している
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ローテーション済みの古いリフレッシュトークンが再利用された
+	ErrRefreshTokenReused = errors.New("refresh token reused")
 )
 
+// ログインした端末の情報
+type DeviceInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
 type SessionUsecase interface {
 	Save(cts context.Context, key string, value interface{}, expired time.Duration) (*model.Session, error)
 	Get(ctx context.Context, key string) (*model.Session, error)
 	Delete(ctx context.Context, key string) error
+
+	StartDeviceSession(ctx context.Context, userID string, device DeviceInfo) (*model.DeviceSession, string, error)
+	RotateRefreshToken(ctx context.Context, refreshToken string, device DeviceInfo) (*model.DeviceSession, string, error)
+	IsDeviceSessionActive(ctx context.Context, userID string, sessionID string) (bool, error)
+	ListDeviceSessions(ctx context.Context, userID string) ([]*model.DeviceSession, error)
+	RevokeDeviceSession(ctx context.Context, userID string, sessionID string) error
+	RevokeOtherDeviceSessions(ctx context.Context, userID string, currentSessionID string) (int, error)
 }
 
 type sessionUsecase struct {
 	sessionRepository repository.SessionRepository
+	// リフレッシュトークン（端末セッション）の有効期限（リフレッシュトークンのクッキー・JWTと同じ値）
+	refreshTokenLifetime time.Duration
 }
 
-func NewSessionUseCase(sessionRepository repository.SessionRepository) SessionUsecase {
+func NewSessionUseCase(sessionRepository repository.SessionRepository, refreshTokenLifetime time.Duration) SessionUsecase {
 	return &sessionUsecase{
-		sessionRepository: sessionRepository,
+		sessionRepository:    sessionRepository,
+		refreshTokenLifetime: refreshTokenLifetime,
 	}
 }
 
@@ -51,3 +86,211 @@ func (s *sessionUsecase) Get(ctx context.Context, key string) (*model.Session, e
 func (s *sessionUsecase) Delete(ctx context.Context, sessionID string) error {
 	return s.sessionRepository.Delete(ctx, sessionID)
 }
+
+// ログイン時に端末セッションを作成し、最初のリフレッシュトークンを発行する
+func (s *sessionUsecase) StartDeviceSession(ctx context.Context, userID string, device DeviceInfo) (*model.DeviceSession, string, error) {
+	sessionID, err := uuid.NewV7()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	session := &model.DeviceSession{
+		ID:         sessionID.String(),
+		UserID:     userID,
+		DeviceName: deviceNameFromUserAgent(device.UserAgent),
+		UserAgent:  device.UserAgent,
+		IPAddress:  device.IPAddress,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+
+	refreshToken, err := s.issueRefreshToken(ctx, session)
+	if err != nil {
+		return nil, "", err
+	}
+	return session, refreshToken, nil
+}
+
+// リフレッシュトークンを検証し、新しいトークンにローテーションする
+// ローテーション済みのトークンが提示された場合は盗用とみなし、セッションごと失効させる
+// ただし直前のトークンは猶予期間内であれば、同時のリフレッシュ（複数のタブなど）として現在のトークンを返す
+func (s *sessionUsecase) RotateRefreshToken(ctx context.Context, refreshToken string, device DeviceInfo) (*model.DeviceSession, string, error) {
+	claims, err := config.ParseRefreshToken(refreshToken)
+	if err != nil || claims.SessionID == "" || claims.RegisteredClaims.ID == "" {
+		return nil, "", ErrInvalidRefreshToken
+	}
+	tokenID := claims.RegisteredClaims.ID
+
+	// 他のリクエストと同時に更新した場合は、読み直して判定し直す
+	for range maxRotateAttempts {
+		session, err := s.sessionRepository.GetDeviceSession(ctx, claims.SessionID)
+		if err != nil {
+			return nil, "", err
+		}
+		if session == nil || session.UserID != claims.Id {
+			return nil, "", ErrInvalidRefreshToken
+		}
+
+		switch {
+		case session.TokenID == tokenID:
+			rotated, newRefreshToken, err := s.rotate(ctx, session, device)
+			if err != nil {
+				return nil, "", err
+			}
+			if rotated == nil {
+				continue
+			}
+			return rotated, newRefreshToken, nil
+
+		case session.PreviousTokenID == tokenID && time.Since(session.RotatedAt) <= RefreshTokenReuseGracePeriod:
+			// 直前に他のリクエストがローテーションしたため、現在のトークンを発行し直す
+			currentRefreshToken, err := config.GenerateRefreshToken(session.UserID, session.ID, session.TokenID)
+			if err != nil {
+				return nil, "", err
+			}
+			return session, currentRefreshToken, nil
+
+		default:
+			if err := s.sessionRepository.DeleteDeviceSession(ctx, session.UserID, session.ID); err != nil {
+				return nil, "", err
+			}
+			return nil, "", ErrRefreshTokenReused
+		}
+	}
+	return nil, "", ErrInvalidRefreshToken
+}
+
+// 現在のトークンから新しいトークンにローテーションする
+// 他のリクエストが先にローテーションした場合はnilを返す
+func (s *sessionUsecase) rotate(ctx context.Context, session *model.DeviceSession, device DeviceInfo) (*model.DeviceSession, string, error) {
+	tokenID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	rotated := *session
+	rotated.PreviousTokenID = session.TokenID
+	rotated.TokenID = tokenID.String()
+	rotated.RotatedAt = now
+	rotated.UserAgent = device.UserAgent
+	rotated.IPAddress = device.IPAddress
+	rotated.LastUsedAt = now
+
+	refreshToken, err := config.GenerateRefreshToken(rotated.UserID, rotated.ID, rotated.TokenID)
+	if err != nil {
+		return nil, "", err
+	}
+	swapped, err := s.sessionRepository.CompareAndSwapDeviceSession(ctx, &rotated, session.TokenID, s.refreshTokenLifetime)
+	if err != nil || !swapped {
+		return nil, "", err
+	}
+	return &rotated, refreshToken, nil
+}
+
+// 端末セッションが失効していないかを確認する
+func (s *sessionUsecase) IsDeviceSessionActive(ctx context.Context, userID, sessionID string) (bool, error) {
+	session, err := s.sessionRepository.GetDeviceSession(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+	return session != nil && session.UserID == userID, nil
+}
+
+// 端末セッションを最終利用日時の新しい順に取得する
+func (s *sessionUsecase) ListDeviceSessions(ctx context.Context, userID string) ([]*model.DeviceSession, error) {
+	sessions, err := s.sessionRepository.ListDeviceSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+// 指定した端末セッションを失効させる（他人のセッションは失効できない）
+func (s *sessionUsecase) RevokeDeviceSession(ctx context.Context, userID, sessionID string) error {
+	session, err := s.sessionRepository.GetDeviceSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if session == nil || session.UserID != userID {
+		return ErrResourceNotFound
+	}
+	return s.sessionRepository.DeleteDeviceSession(ctx, userID, sessionID)
+}
+
+// 現在の端末以外のセッションを全て失効させ、失効させた件数を返す
+func (s *sessionUsecase) RevokeOtherDeviceSessions(ctx context.Context, userID, currentSessionID string) (int, error) {
+	sessions, err := s.sessionRepository.ListDeviceSessions(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID == currentSessionID {
+			continue
+		}
+		if err := s.sessionRepository.DeleteDeviceSession(ctx, userID, session.ID); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// 新しいトークンIDでリフレッシュトークンを発行し、セッションに保存する
+func (s *sessionUsecase) issueRefreshToken(ctx context.Context, session *model.DeviceSession) (string, error) {
+	tokenID, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+
+	refreshToken, err := config.GenerateRefreshToken(session.UserID, session.ID, tokenID.String())
+	if err != nil {
+		return "", err
+	}
+
+	session.TokenID = tokenID.String()
+	if err := s.sessionRepository.SaveDeviceSession(ctx, session, s.refreshTokenLifetime); err != nil {
+		return "", err
+	}
+	return refreshToken, nil
+}
+
+// User-Agentから表示用の端末名を推定する
+func deviceNameFromUserAgent(userAgent string) string {
+	var device string
+	switch {
+	case strings.Contains(userAgent, "iPhone"):
+		device = "iPhone"
+	case strings.Contains(userAgent, "iPad"):
+		device = "iPad"
+	case strings.Contains(userAgent, "Android"):
+		device = "Android"
+	case strings.Contains(userAgent, "Macintosh"):
+		device = "Mac"
+	case strings.Contains(userAgent, "Windows"):
+		device = "Windows"
+	case strings.Contains(userAgent, "Linux"):
+		device = "Linux"
+	default:
+		return "Unknown device"
+	}
+
+	switch {
+	case strings.Contains(userAgent, "Edg/"):
+		return device + " (Edge)"
+	case strings.Contains(userAgent, "Chrome/"):
+		return device + " (Chrome)"
+	case strings.Contains(userAgent, "Firefox/"):
+		return device + " (Firefox)"
+	case strings.Contains(userAgent, "Safari/"):
+		return device + " (Safari)"
+	default:
+		return device
+	}
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/noonyuu/nfc/back/internal/config"
+	"github.com/noonyuu/nfc/back/internal/domain/model"
+)
+
+const testRefreshTokenLifetime = 30 * 24 * time.Hour
+
+// テスト用の署名鍵を生成して読み込む
+func initTestJWT(t *testing.T) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "test.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg := config.JWTConfig{KeysDir: dir, AccessTokenLifetime: 15 * time.Minute, RefreshTokenLifetime: testRefreshTokenLifetime}
+	if _, err := config.InitJWT(cfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// 端末セッションをメモリに保存するリポジトリ
+type memorySessionRepository struct {
+	mu       sync.Mutex
+	sessions map[string]model.DeviceSession
+}
+
+func newMemorySessionRepository() *memorySessionRepository {
+	return &memorySessionRepository{sessions: map[string]model.DeviceSession{}}
+}
+
+func (r *memorySessionRepository) Save(ctx context.Context, session *model.Session) error {
+	return nil
+}
+
+func (r *memorySessionRepository) Get(ctx context.Context, key string) (*model.Session, error) {
+	return nil, nil
+}
+
+func (r *memorySessionRepository) Delete(ctx context.Context, key string) error {
+	return nil
+}
+
+func (r *memorySessionRepository) SaveDeviceSession(ctx context.Context, session *model.DeviceSession, expiration time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions[session.ID] = *session
+	return nil
+}
+
+func (r *memorySessionRepository) CompareAndSwapDeviceSession(ctx context.Context, session *model.DeviceSession, expectedTokenID string, expiration time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.sessions[session.ID]
+	if !ok || stored.TokenID != expectedTokenID {
+		return false, nil
+	}
+	r.sessions[session.ID] = *session
+	return true, nil
+}
+
+func (r *memorySessionRepository) GetDeviceSession(ctx context.Context, sessionID string) (*model.DeviceSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	session, ok := r.sessions[sessionID]
+	if !ok {
+		return nil, nil
+	}
+	return &session, nil
+}
+
+func (r *memorySessionRepository) ListDeviceSessions(ctx context.Context, userID string) ([]*model.DeviceSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sessions []*model.DeviceSession
+	for _, session := range r.sessions {
+		if session.UserID == userID {
+			session := session
+			sessions = append(sessions, &session)
+		}
+	}
+	return sessions, nil
+}
+
+func (r *memorySessionRepository) DeleteDeviceSession(ctx context.Context, userID, sessionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, sessionID)
+	return nil
+}
+
+// 保存されている端末セッションを書き換える
+func (r *memorySessionRepository) update(sessionID string, fn func(*model.DeviceSession)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	session := r.sessions[sessionID]
+	fn(&session)
+	r.sessions[sessionID] = session
+}
+
+func tokenID(t *testing.T, refreshToken string) string {
+	t.Helper()
+	claims, err := config.ParseRefreshToken(refreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return claims.RegisteredClaims.ID
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	initTestJWT(t)
+	ctx := context.Background()
+	device := DeviceInfo{UserAgent: "Mozilla/5.0 (Macintosh) Chrome/120", IPAddress: "192.0.2.1"}
+
+	tests := []struct {
+		name string
+		// ログインで発行したトークンから、ローテーションに使うトークンを用意する
+		prepare func(t *testing.T, s *sessionUsecase, repo *memorySessionRepository, session *model.DeviceSession, token string) string
+		wantErr error
+		// エラー後も端末セッションが残っているか
+		wantActive bool
+	}{
+		{
+			name: "current token rotates",
+			prepare: func(t *testing.T, s *sessionUsecase, repo *memorySessionRepository, session *model.DeviceSession, token string) string {
+				return token
+			},
+			wantActive: true,
+		},
+		{
+			name: "previous token within the grace period returns the current token",
+			prepare: func(t *testing.T, s *sessionUsecase, repo *memorySessionRepository, session *model.DeviceSession, token string) string {
+				if _, _, err := s.RotateRefreshToken(ctx, token, device); err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			wantActive: true,
+		},
+		{
+			name: "previous token after the grace period is reuse",
+			prepare: func(t *testing.T, s *sessionUsecase, repo *memorySessionRepository, session *model.DeviceSession, token string) string {
+				if _, _, err := s.RotateRefreshToken(ctx, token, device); err != nil {
+					t.Fatal(err)
+				}
+				repo.update(session.ID, func(session *model.DeviceSession) {
+					session.RotatedAt = time.Now().Add(-RefreshTokenReuseGracePeriod - time.Second)
+				})
+				return token
+			},
+			wantErr: ErrRefreshTokenReused,
+		},
+		{
+			name: "token rotated twice is reuse",
+			prepare: func(t *testing.T, s *sessionUsecase, repo *memorySessionRepository, session *model.DeviceSession, token string) string {
+				_, second, err := s.RotateRefreshToken(ctx, token, device)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, _, err := s.RotateRefreshToken(ctx, second, device); err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			wantErr: ErrRefreshTokenReused,
+		},
+		{
+			name: "access token is rejected",
+			prepare: func(t *testing.T, s *sessionUsecase, repo *memorySessionRepository, session *model.DeviceSession, token string) string {
+				accessToken, err := config.GenerateAccessToken(session.UserID, session.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return accessToken
+			},
+			wantErr:    ErrInvalidRefreshToken,
+			wantActive: true,
+		},
+		{
+			name: "revoked session is rejected",
+			prepare: func(t *testing.T, s *sessionUsecase, repo *memorySessionRepository, session *model.DeviceSession, token string) string {
+				if err := s.RevokeDeviceSession(ctx, session.UserID, session.ID); err != nil {
+					t.Fatal(err)
+				}
+				return token
+			},
+			wantErr: ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemorySessionRepository()
+			s := NewSessionUseCase(repo, testRefreshTokenLifetime).(*sessionUsecase)
+			session, token, err := s.StartDeviceSession(ctx, "user-1", device)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			presented := tt.prepare(t, s, repo, session, token)
+			rotated, newToken, err := s.RotateRefreshToken(ctx, presented, device)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, _ := repo.GetDeviceSession(ctx, session.ID)
+			if tt.wantErr != nil {
+				if active := stored != nil; active != tt.wantActive {
+					t.Errorf("session active = %v, want %v", active, tt.wantActive)
+				}
+				return
+			}
+			if stored == nil {
+				t.Fatal("session was revoked")
+			}
+			if got := tokenID(t, newToken); got != stored.TokenID {
+				t.Errorf("returned token id = %s, want current %s", got, stored.TokenID)
+			}
+			if rotated.ID != session.ID {
+				t.Errorf("session id = %s, want %s", rotated.ID, session.ID)
+			}
+		})
+	}
+}
+
+// 同じトークンで同時にリフレッシュしても、チェーンが分岐せずセッションも失効しない
+func TestRotateRefreshTokenConcurrent(t *testing.T) {
+	initTestJWT(t)
+	ctx := context.Background()
+	repo := newMemorySessionRepository()
+	s := NewSessionUseCase(repo, testRefreshTokenLifetime).(*sessionUsecase)
+	session, token, err := s.StartDeviceSession(ctx, "user-1", DeviceInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 20
+	tokens := make([]string, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, tokens[i], errs[i] = s.RotateRefreshToken(ctx, token, DeviceInfo{})
+		}()
+	}
+	wg.Wait()
+
+	stored, _ := repo.GetDeviceSession(ctx, session.ID)
+	if stored == nil {
+		t.Fatal("session was revoked")
+	}
+	for i := range n {
+		if errs[i] != nil {
+			t.Fatalf("request %d: %v", i, errs[i])
+		}
+		if got := tokenID(t, tokens[i]); got != stored.TokenID {
+			t.Errorf("request %d: token id = %s, want %s", i, got, stored.TokenID)
+		}
+	}
+	if stored.PreviousTokenID != tokenID(t, token) {
+		t.Errorf("previous token id = %s, want the presented token", stored.PreviousTokenID)
+	}
+}
@@ -0,0 +1,31 @@
+// front/src/graph のオペレーションから、バックエンドに登録するクエリのマニフェストを生成する
+// Apollo クライアントが送るクエリ（__typename を付けて print したもの）と同じ文字列のハッシュにする
+// 使い方: node scripts/persisted-queries.mjs [出力先（既定: ../back/persisted_queries.json）]
+import { createHash } from "node:crypto";
+import { readdir, readFile, writeFile } from "node:fs/promises";
+import { join } from "node:path";
+import { fileURLToPath } from "node:url";
+
+import { addTypenameToDocument } from "@apollo/client/utilities";
+import { parse, print } from "graphql";
+
+const root = fileURLToPath(new URL("..", import.meta.url));
+const sourceDir = join(root, "src/graph");
+const output = process.argv[2] ?? join(root, "../back/persisted_queries.json");
+
+const manifest = {};
+for (const file of (await readdir(sourceDir)).sort()) {
+  if (!file.endsWith(".ts")) continue;
+  const source = await readFile(join(sourceDir, file), "utf8");
+  for (const [, body] of source.matchAll(/gql`([^`]*)`/g)) {
+    if (body.includes("${")) {
+      throw new Error(`${file}: フラグメントの埋め込みには対応していません`);
+    }
+    const query = print(addTypenameToDocument(parse(body)));
+    const hash = createHash("sha256").update(query).digest("hex");
+    manifest[hash] = query;
+  }
+}
+
+await writeFile(output, JSON.stringify(manifest, null, 2) + "\n");
+console.log(`${Object.keys(manifest).length} 件のクエリを ${output} に書き出しました`);
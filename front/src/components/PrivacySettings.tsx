@@ -0,0 +1,135 @@
+import { useMutation, useQuery } from "@apollo/client";
+
+import {
+  GET_MY_PRIVACY_SETTINGS,
+  UPDATE_PRIVACY_SETTINGS,
+} from "@/graph/user";
+import {
+  CardPrivacySettings,
+  PrivacySettings as PrivacySettingsType,
+  Visibility,
+} from "@/types/user";
+
+type VisibilityField = Exclude<keyof PrivacySettingsType, "card">;
+
+const VISIBILITY_OPTIONS: { value: Visibility; label: string }[] = [
+  { value: "PUBLIC", label: "全員" },
+  { value: "CONNECTIONS", label: "共同制作者とイベント運営者" },
+  { value: "EVENT_ORGANIZERS", label: "イベント運営者のみ" },
+  { value: "SELF", label: "自分のみ" },
+];
+
+const FIELDS: { field: VisibilityField; label: string }[] = [
+  { field: "email", label: "メールアドレス" },
+  { field: "name", label: "氏名" },
+  { field: "avatarUrl", label: "アイコン" },
+  { field: "graduationYear", label: "卒業年" },
+  { field: "affiliation", label: "所属" },
+  { field: "bio", label: "自己紹介" },
+];
+
+// NFCカードに表示できる項目
+const CARD_FIELDS: { field: keyof CardPrivacySettings; label: string }[] = [
+  { field: "avatarUrl", label: "アイコン" },
+  { field: "graduationYear", label: "卒業年" },
+  { field: "affiliation", label: "所属" },
+  { field: "bio", label: "自己紹介" },
+];
+
+// 公開プロフィールとNFCカードの公開設定（変更するとすぐに保存される）
+export const PrivacySettings = () => {
+  const { data, loading } = useQuery<{
+    myPrivacySettings: PrivacySettingsType;
+  }>(GET_MY_PRIVACY_SETTINGS);
+  const [updatePrivacySettings, { loading: saving, error }] = useMutation(
+    UPDATE_PRIVACY_SETTINGS,
+  );
+
+  const settings = data?.myPrivacySettings;
+
+  if (loading || !settings) {
+    return null;
+  }
+
+  const update = (input: Record<string, unknown>) =>
+    updatePrivacySettings({
+      variables: { input },
+      refetchQueries: [GET_MY_PRIVACY_SETTINGS],
+    }).catch((e) => {
+      console.error("公開設定の更新に失敗しました:", e);
+    });
+
+  return (
+    <div className="space-y-6">
+      <div>
+        <h3 className="text-lg font-semibold text-slate-800">公開設定</h3>
+        <p className="mt-1 text-sm text-slate-600">
+          プロフィールの各項目を表示する相手を選べます。
+        </p>
+      </div>
+
+      <div className="space-y-3">
+        {FIELDS.map(({ field, label }) => (
+          <div
+            key={field}
+            className="flex items-center justify-between gap-4"
+          >
+            <label
+              htmlFor={`visibility-${field}`}
+              className="text-sm font-medium text-slate-700"
+            >
+              {label}
+            </label>
+            <select
+              id={`visibility-${field}`}
+              value={settings[field]}
+              disabled={saving}
+              onChange={(e) => update({ [field]: e.target.value })}
+              className="rounded-lg border border-slate-300 px-3 py-2 text-sm shadow-sm focus:border-sky-500 focus:ring-sky-500 focus:outline-none"
+            >
+              {VISIBILITY_OPTIONS.map(({ value, label }) => (
+                <option key={value} value={value}>
+                  {label}
+                </option>
+              ))}
+            </select>
+          </div>
+        ))}
+      </div>
+
+      <div>
+        <h4 className="text-sm font-semibold text-slate-800">
+          NFCカードに表示する項目
+        </h4>
+        <p className="mt-1 text-xs text-slate-500">
+          カードを読み取った人には、上の公開設定に関わらず表示されます。
+        </p>
+        <div className="mt-3 space-y-2">
+          {CARD_FIELDS.map(({ field, label }) => (
+            <label
+              key={field}
+              className="flex items-center gap-2 text-sm text-slate-700"
+            >
+              <input
+                type="checkbox"
+                checked={settings.card[field]}
+                disabled={saving}
+                onChange={(e) =>
+                  update({ card: { [field]: e.target.checked } })
+                }
+                className="h-4 w-4 rounded border-slate-300 text-sky-600 focus:ring-sky-500"
+              />
+              {label}
+            </label>
+          ))}
+        </div>
+      </div>
+
+      {error && (
+        <p className="text-xs text-red-600">
+          公開設定の更新に失敗しました。もう一度お試しください。
+        </p>
+      )}
+    </div>
+  );
+};
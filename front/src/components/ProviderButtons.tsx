@@ -0,0 +1,79 @@
+import { useEffect, useState } from "react";
+
+import github from "@/assets/icons/github.svg";
+import google from "@/assets/icons/google.svg";
+
+import { Button } from "@/components/ui/button";
+
+type AuthProvider = {
+  name: string;
+  type: string;
+  display_name: string;
+};
+
+// 種類ごとのアイコン（無いものはアイコンなしで表示）
+const PROVIDER_ICONS: Record<string, string> = {
+  google,
+  github,
+};
+
+// 初回表示用（取得に失敗した場合もこの一覧を表示する）
+const DEFAULT_PROVIDERS: AuthProvider[] = [
+  { name: "google", type: "google", display_name: "Google" },
+  { name: "github", type: "github", display_name: "GitHub" },
+];
+
+type ProviderButtonsProps = {
+  // ボタンの文言（例: 「でログイン」）
+  suffix: string;
+};
+
+export const ProviderButtons = ({ suffix }: ProviderButtonsProps) => {
+  const HOST_URL = import.meta.env.VITE_HOST_URL || "";
+  const [providers, setProviders] = useState<AuthProvider[]>(DEFAULT_PROVIDERS);
+
+  useEffect(() => {
+    (async () => {
+      try {
+        const res = await fetch(`${HOST_URL}api/v1/auth/providers`);
+        if (res.ok) {
+          const data = await res.json();
+          if (Array.isArray(data?.providers)) {
+            setProviders(data.providers);
+          }
+        }
+      } catch (error) {
+        console.error("Error fetching providers:", error);
+      }
+    })();
+  }, [HOST_URL]);
+
+  const handleButtonClick = (provider: string) => {
+    window.location.href =
+      HOST_URL + "api/v1/auth/" + provider + "?redirect_path=/";
+  };
+
+  return (
+    <>
+      {providers.map((provider) => (
+        <Button
+          key={provider.name}
+          variant="sns"
+          size="lg"
+          icon={!!PROVIDER_ICONS[provider.type]}
+          onClick={() => handleButtonClick(provider.name)}
+        >
+          {PROVIDER_ICONS[provider.type] && (
+            <img
+              src={PROVIDER_ICONS[provider.type]}
+              alt={`${provider.type} icon`}
+              className="size-4"
+            />
+          )}
+          {provider.display_name}
+          {suffix}
+        </Button>
+      ))}
+    </>
+  );
+};
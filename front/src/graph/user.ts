@@ -34,3 +34,41 @@ export const SEARCH_USERS = gql`
     }
   }
 `;
+
+export const GET_MY_PRIVACY_SETTINGS = gql`
+  query MyPrivacySettings {
+    myPrivacySettings {
+      email
+      name
+      avatarUrl
+      graduationYear
+      affiliation
+      bio
+      card {
+        avatarUrl
+        graduationYear
+        affiliation
+        bio
+      }
+    }
+  }
+`;
+
+export const UPDATE_PRIVACY_SETTINGS = gql`
+  mutation UpdatePrivacySettings($input: UpdatePrivacySettings!) {
+    updatePrivacySettings(input: $input) {
+      email
+      name
+      avatarUrl
+      graduationYear
+      affiliation
+      bio
+      card {
+        avatarUrl
+        graduationYear
+        affiliation
+        bio
+      }
+    }
+  }
+`;
@@ -130,6 +130,13 @@ export const GET_NFC_DATA = gql`
   }
 `;
 
+// NFCカードの読み取りを記録する（カードの持ち主に通知される）
+export const RECORD_CARD_SCAN = gql`
+  mutation RecordCardScan($workProfileId: String!) {
+    recordCardScan(workProfileId: $workProfileId)
+  }
+`;
+
 export const GET_PROFILE_WORKS = gql`
   query ($profileId: String!) {
     worksByProfileId(profileId: $profileId) {
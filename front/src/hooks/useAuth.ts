@@ -2,6 +2,7 @@ import { useEffect, useState, useCallback } from "react";
 import { useLazyQuery } from "@apollo/client";
 import { Profile } from "@/types/user";
 import { GET_PROFILE } from "@/graph/user";
+import { CSRF_HEADER, getCsrfToken } from "@/lib/csrf";
 
 export const STORAGE_KEY = "nfc_userId";
 
@@ -68,7 +69,9 @@ export function useAuth() {
   // ログアウト時はlocalStorageをクリアしてuserをリセット
   const logout = useCallback(async () => {
     const res = await fetch(`${HOST_URL}api/v1/auth/logout`, {
+      method: "POST",
       credentials: "include", // Cookieを送る
+      headers: { [CSRF_HEADER]: await getCsrfToken() },
     });
     if (!res.ok) {
       console.error("Logout failed:", res.status);
@@ -0,0 +1,26 @@
+const HOST_URL = import.meta.env.VITE_HOST_URL || "";
+
+export const CSRF_HEADER = "X-CSRF-Token";
+
+let token: Promise<string> | null = null;
+
+// 状態を変更するリクエストに付けるCSRFトークン（初回のみサーバーから取得）
+export function getCsrfToken(): Promise<string> {
+  if (!token) {
+    token = fetch(`${HOST_URL}api/v1/auth/csrf`, {
+      credentials: "include", // Cookieを受け取る
+    })
+      .then((res) => {
+        if (!res.ok) {
+          throw new Error(`csrf token request failed: ${res.status}`);
+        }
+        return res.json();
+      })
+      .then((data) => data.csrf_token as string)
+      .catch((error) => {
+        token = null;
+        throw error;
+      });
+  }
+  return token;
+}
@@ -7,9 +7,12 @@ import {
   createHttpLink,
   InMemoryCache,
 } from "@apollo/client";
+import { setContext } from "@apollo/client/link/context";
+import { createPersistedQueryLink } from "@apollo/client/link/persisted-queries";
 
 import "./style/index.css";
 import { routeTree } from "./routeTree.gen";
+import { CSRF_HEADER, getCsrfToken } from "./lib/csrf";
 
 // 環境変数
 const HOST_URL = import.meta.env.VITE_HOST_URL || "";
@@ -17,12 +20,34 @@ const HOST_URL = import.meta.env.VITE_HOST_URL || "";
 // HTTP リンクの作成
 const httpLink = createHttpLink({
   uri: HOST_URL + "api/query",
-  // credentials: "include",
+  credentials: "include",
+});
+
+// CSRF トークンをヘッダーに付与
+const csrfLink = setContext(async (_, { headers }) => ({
+  headers: {
+    ...headers,
+    [CSRF_HEADER]: await getCsrfToken(),
+  },
+}));
+
+// クエリの代わりにハッシュを送る（未登録の場合はクエリ全体を送り直す）
+// 本番環境ではビルド時に生成したマニフェストのクエリだけが許可される（scripts/persisted-queries.mjs）
+const persistedQueryLink = createPersistedQueryLink({
+  sha256: async (query) => {
+    const digest = await crypto.subtle.digest(
+      "SHA-256",
+      new TextEncoder().encode(query),
+    );
+    return Array.from(new Uint8Array(digest))
+      .map((b) => b.toString(16).padStart(2, "0"))
+      .join("");
+  },
 });
 
 // Apollo クライアントの作成
 export const client = new ApolloClient({
-  link: httpLink,
+  link: csrfLink.concat(persistedQueryLink).concat(httpLink),
   cache: new InMemoryCache(),
 });
 
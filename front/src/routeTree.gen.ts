@@ -16,6 +16,7 @@ import { Route as RegisterImport } from './routes/register'
 import { Route as PolicyImport } from './routes/policy'
 import { Route as LoginImport } from './routes/login'
 import { Route as IndexImport } from './routes/index'
+import { Route as AccountConflictImport } from './routes/account-conflict'
 import { Route as EventIndexImport } from './routes/event/index'
 import { Route as ProfileProjectlistImport } from './routes/profile/project_list'
 import { Route as ProfileEditImport } from './routes/profile/edit'
@@ -57,6 +58,12 @@ const IndexRoute = IndexImport.update({
   getParentRoute: () => rootRoute,
 } as any)
 
+const AccountConflictRoute = AccountConflictImport.update({
+  id: '/account-conflict',
+  path: '/account-conflict',
+  getParentRoute: () => rootRoute,
+} as any)
+
 const EventIndexRoute = EventIndexImport.update({
   id: '/event/',
   path: '/event/',
@@ -116,6 +123,13 @@ declare module '@tanstack/react-router' {
       preLoaderRoute: typeof IndexImport
       parentRoute: typeof rootRoute
     }
+    '/account-conflict': {
+      id: '/account-conflict'
+      path: '/account-conflict'
+      fullPath: '/account-conflict'
+      preLoaderRoute: typeof AccountConflictImport
+      parentRoute: typeof rootRoute
+    }
     '/login': {
       id: '/login'
       path: '/login'
@@ -207,6 +221,7 @@ declare module '@tanstack/react-router' {
 
 export interface FileRoutesByFullPath {
   '/': typeof IndexRoute
+  '/account-conflict': typeof AccountConflictRoute
   '/login': typeof LoginRoute
   '/policy': typeof PolicyRoute
   '/register': typeof RegisterRoute
@@ -223,6 +238,7 @@ export interface FileRoutesByFullPath {
 
 export interface FileRoutesByTo {
   '/': typeof IndexRoute
+  '/account-conflict': typeof AccountConflictRoute
   '/login': typeof LoginRoute
   '/policy': typeof PolicyRoute
   '/register': typeof RegisterRoute
@@ -240,6 +256,7 @@ export interface FileRoutesByTo {
 export interface FileRoutesById {
   __root__: typeof rootRoute
   '/': typeof IndexRoute
+  '/account-conflict': typeof AccountConflictRoute
   '/login': typeof LoginRoute
   '/policy': typeof PolicyRoute
   '/register': typeof RegisterRoute
@@ -258,6 +275,7 @@ export interface FileRouteTypes {
   fileRoutesByFullPath: FileRoutesByFullPath
   fullPaths:
     | '/'
+    | '/account-conflict'
     | '/login'
     | '/policy'
     | '/register'
@@ -273,6 +291,7 @@ export interface FileRouteTypes {
   fileRoutesByTo: FileRoutesByTo
   to:
     | '/'
+    | '/account-conflict'
     | '/login'
     | '/policy'
     | '/register'
@@ -288,6 +307,7 @@ export interface FileRouteTypes {
   id:
     | '__root__'
     | '/'
+    | '/account-conflict'
     | '/login'
     | '/policy'
     | '/register'
@@ -305,6 +325,7 @@ export interface FileRouteTypes {
 
 export interface RootRouteChildren {
   IndexRoute: typeof IndexRoute
+  AccountConflictRoute: typeof AccountConflictRoute
   LoginRoute: typeof LoginRoute
   PolicyRoute: typeof PolicyRoute
   RegisterRoute: typeof RegisterRoute
@@ -321,6 +342,7 @@ export interface RootRouteChildren {
 
 const rootRouteChildren: RootRouteChildren = {
   IndexRoute: IndexRoute,
+  AccountConflictRoute: AccountConflictRoute,
   LoginRoute: LoginRoute,
   PolicyRoute: PolicyRoute,
   RegisterRoute: RegisterRoute,
@@ -346,6 +368,7 @@ export const routeTree = rootRoute
       "filePath": "__root.tsx",
       "children": [
         "/",
+        "/account-conflict",
         "/login",
         "/policy",
         "/register",
@@ -363,6 +386,9 @@ export const routeTree = rootRoute
     "/": {
       "filePath": "index.tsx"
     },
+    "/account-conflict": {
+      "filePath": "account-conflict.tsx"
+    },
     "/login": {
       "filePath": "login.tsx"
     },
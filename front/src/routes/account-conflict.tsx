@@ -0,0 +1,50 @@
+import { createFileRoute, Link } from "@tanstack/react-router";
+
+type AccountConflictSearch = {
+  provider?: string;
+  reason?: string;
+};
+
+export const Route = createFileRoute("/account-conflict")({
+  validateSearch: (search: Record<string, unknown>): AccountConflictSearch => ({
+    provider: typeof search.provider === "string" ? search.provider : undefined,
+    reason: typeof search.reason === "string" ? search.reason : undefined,
+  }),
+  component: RouteComponent,
+});
+
+const PROVIDER_LABELS: Record<string, string> = {
+  google: "Google",
+  github: "GitHub",
+};
+
+const REASON_MESSAGES: Record<string, string> = {
+  email_in_use:
+    "このメールアドレスは別のログイン方法で登録済みです。登録済みの方法でログインした後、プロフィールからログイン方法を連携してください。",
+  linked_to_other_user: "このアカウントは既に別のユーザーに連携されています。",
+  provider_already_linked:
+    "同じ種類のログイン方法が既に連携されています。連携を解除してから再度お試しください。",
+};
+
+function RouteComponent() {
+  const { provider, reason } = Route.useSearch();
+  const providerLabel = (provider && PROVIDER_LABELS[provider]) || provider;
+  const message =
+    (reason && REASON_MESSAGES[reason]) || "アカウントの連携に失敗しました。";
+
+  return (
+    <section className="flex flex-col gap-8 pt-8">
+      <div className="font-main text-center text-xl">
+        {providerLabel
+          ? `${providerLabel}アカウントを連携できません`
+          : "アカウントを連携できません"}
+      </div>
+      <div className="flex flex-col gap-y-3 rounded-md border border-gray-300 bg-white px-8 py-8">
+        <p className="text-gray-700">{message}</p>
+        <div className="text-center">
+          <Link to="/login">ログインページへ戻る</Link>
+        </div>
+      </div>
+    </section>
+  );
+}
@@ -1,12 +1,12 @@
 import { useEffect, useState } from "react";
 import { createFileRoute, useParams } from "@tanstack/react-router";
-import { useQuery } from "@apollo/client";
+import { useMutation, useQuery } from "@apollo/client";
 import { AlertTriangle, Scan } from "lucide-react";
 import { AnimatePresence, motion } from "framer-motion";
 
 import { CardData } from "@/types/card";
 import { CardStack } from "@/components/CardStack";
-import { GET_NFC_DATA } from "@/graph/work";
+import { GET_NFC_DATA, RECORD_CARD_SCAN } from "@/graph/work";
 
 export const Route = createFileRoute("/nfc/$workId/")({
   component: RouteComponent,
@@ -35,6 +35,15 @@ function RouteComponent() {
 
   const card = data?.workProfile;
 
+  // カードの読み取りは画面を開いたときに1回だけ記録する（再取得では記録しない）
+  const [recordCardScan] = useMutation(RECORD_CARD_SCAN);
+  useEffect(() => {
+    if (!workId) return;
+    recordCardScan({ variables: { workProfileId: workId } }).catch(() => {
+      // 記録に失敗してもカードの表示には影響させない
+    });
+  }, [recordCardScan, workId]);
+
   useEffect(() => {
     setLoading(queryLoading);
 
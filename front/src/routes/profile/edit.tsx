@@ -4,6 +4,7 @@ import { valibotResolver } from "@hookform/resolvers/valibot";
 import { useForm, FormProvider } from "react-hook-form";
 import { useMutation } from "@apollo/client";
 
+import { PrivacySettings } from "@/components/PrivacySettings";
 import { useAuth } from "@/hooks/useAuth";
 import { ProfileSchema, ProfileSchemaType } from "@/schema/profile";
 import { UPDATE_PROFILE } from "@/graph/user";
@@ -244,6 +245,8 @@ function RouteComponent() {
               </div>
             </form>
           </FormProvider>
+
+          <PrivacySettings />
         </div>
       </div>
     </div>
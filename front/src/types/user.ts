@@ -25,3 +25,22 @@ export type UpdateProfile = Pick<
   Profile,
   "id" | "nickName" | "graduationYear" | "affiliation" | "bio"
 >;
+
+export type Visibility = "PUBLIC" | "CONNECTIONS" | "EVENT_ORGANIZERS" | "SELF";
+
+export type CardPrivacySettings = {
+  avatarUrl: boolean;
+  graduationYear: boolean;
+  affiliation: boolean;
+  bio: boolean;
+};
+
+export type PrivacySettings = {
+  email: Visibility;
+  name: Visibility;
+  avatarUrl: Visibility;
+  graduationYear: Visibility;
+  affiliation: Visibility;
+  bio: Visibility;
+  card: CardPrivacySettings;
+};